@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"jezz-go-spotify-integration/internal/model"
+)
+
+func (a *App) albumCommand() *Command {
+	return &Command{
+		Name:    "album",
+		Summary: "Get albums, their tracks and new releases",
+		Commands: []*Command{
+			a.albumGetCommand(),
+			a.albumTracksCommand(),
+			a.albumNewReleasesCommand(),
+		},
+	}
+}
+
+func (a *App) albumGetCommand() *Command {
+	var market optionalString
+	return &Command{
+		Name:    "get",
+		Args:    "<album>...",
+		Summary: "Get one or more albums by ID, Spotify URI or share link",
+		Flags: func(fs *flag.FlagSet) {
+			marketFlag(fs, &market)
+		},
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 1, -1, "album"); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				album, gErr := services.Albums.GetAlbum(ctx, a.market(&market), args[0])
+				if gErr != nil {
+					return gErr
+				}
+				return a.print(album, nil)
+			}
+			albums, err := services.Albums.GetAlbums(ctx, a.market(&market), args...)
+			return printFound(a, albums, func(album model.Album) bool {
+				return album.ID != ""
+			}, err)
+		},
+	}
+}
+
+func (a *App) albumTracksCommand() *Command {
+	var market optionalString
+	var limit, offset optionalInt
+	var allItems allItemsFlags
+	return &Command{
+		Name:    "tracks",
+		Args:    "<album>",
+		Summary: "Get the tracks of an album",
+		Flags: func(fs *flag.FlagSet) {
+			marketFlag(fs, &market)
+			paginationFlags(fs, &limit, &offset)
+			allItems.register(fs)
+		},
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 1, 1, "album"); err != nil {
+				return err
+			}
+			if err := allItems.validate(&limit, &offset); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			if allItems.all {
+				return printAll(a, services.Albums.AlbumTracksSeq(ctx, a.market(&market), allItems.maxItems, args[0]))
+			}
+			tracks, err := services.Albums.GetAlbumTracks(ctx, a.market(&market), limit.value, offset.value, args[0])
+			if err != nil {
+				return err
+			}
+			return a.print(tracks, nil)
+		},
+	}
+}
+
+func (a *App) albumNewReleasesCommand() *Command {
+	var limit, offset optionalInt
+	var allItems allItemsFlags
+	return &Command{
+		Name:    "new-releases",
+		Summary: "Get the albums featured as new releases",
+		Flags: func(fs *flag.FlagSet) {
+			paginationFlags(fs, &limit, &offset)
+			allItems.register(fs)
+		},
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 0, 0, ""); err != nil {
+				return err
+			}
+			if err := allItems.validate(&limit, &offset); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			if allItems.all {
+				return printAll(a, services.Albums.NewReleasesSeq(ctx, allItems.maxItems))
+			}
+			releases, err := services.Albums.GetNewReleases(ctx, limit.value, offset.value)
+			if err != nil {
+				return err
+			}
+			return a.print(releases, nil)
+		},
+	}
+}
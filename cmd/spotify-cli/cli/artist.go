@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"jezz-go-spotify-integration/internal/model"
+)
+
+func (a *App) artistCommand() *Command {
+	return &Command{
+		Name:    "artist",
+		Summary: "Get artists, their albums and top tracks",
+		Commands: []*Command{
+			a.artistGetCommand(),
+			a.artistAlbumsCommand(),
+			a.artistTopTracksCommand(),
+		},
+	}
+}
+
+func (a *App) artistGetCommand() *Command {
+	return &Command{
+		Name:    "get",
+		Args:    "<artist>...",
+		Summary: "Get one or more artists by ID, Spotify URI or share link",
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 1, -1, "artist"); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				artist, gErr := services.Artists.GetArtist(ctx, args[0])
+				if gErr != nil {
+					return gErr
+				}
+				return a.print(artist, nil)
+			}
+			artists, err := services.Artists.GetArtists(ctx, args...)
+			return printFound(a, artists, func(artist model.Artist) bool {
+				return artist.ID != ""
+			}, err)
+		},
+	}
+}
+
+func (a *App) artistAlbumsCommand() *Command {
+	var market optionalString
+	var limit, offset optionalInt
+	var groups albumGroupsFlag
+	var allItems allItemsFlags
+	return &Command{
+		Name:    "albums",
+		Args:    "<artist>",
+		Summary: "Get the albums of an artist",
+		Flags: func(fs *flag.FlagSet) {
+			marketFlag(fs, &market)
+			paginationFlags(fs, &limit, &offset)
+			fs.Var(&groups, "groups", "album groups to include, separated by commas: album, single, appears_on and/or compilation")
+			allItems.register(fs)
+		},
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 1, 1, "artist"); err != nil {
+				return err
+			}
+			if err := allItems.validate(&limit, &offset); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			if allItems.all {
+				return printAll(a, services.Artists.ArtistAlbumsSeq(ctx, a.market(&market), groups.get(), allItems.maxItems, args[0]))
+			}
+			albums, err := services.Artists.GetArtistAlbums(ctx, a.market(&market), groups.get(), limit.value, offset.value, args[0])
+			if err != nil {
+				return err
+			}
+			return a.print(albums, nil)
+		},
+	}
+}
+
+func (a *App) artistTopTracksCommand() *Command {
+	var market optionalString
+	return &Command{
+		Name:    "top-tracks",
+		Args:    "<artist>",
+		Summary: "Get the top tracks of an artist",
+		Flags: func(fs *flag.FlagSet) {
+			marketFlag(fs, &market)
+		},
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 1, 1, "artist"); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			tracks, err := services.Artists.GetArtistTopTracks(ctx, a.market(&market), args[0])
+			if err != nil {
+				return err
+			}
+			return a.print(tracks, nil)
+		},
+	}
+}
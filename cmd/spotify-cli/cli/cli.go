@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/config"
+	"jezz-go-spotify-integration/internal/render"
+	"jezz-go-spotify-integration/internal/service"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const AppName = "spotify-cli"
+
+// Exit codes of the CLI, so scripts can react to the kind of failure
+const (
+	ExitOK          = 0
+	ExitFailure     = 1
+	ExitUsage       = 2
+	ExitNotFound    = 3
+	ExitAuth        = 4
+	ExitRateLimited = 5
+	ExitUnavailable = 6
+)
+
+type Services struct {
+	Artists service.ArtistsService
+	Albums  service.AlbumsService
+	Tracks  service.TracksService
+}
+
+// ServicesLoader builds the services from the settings once the command line is known to be valid, so usage errors
+// and help don't require credentials. Progress is reported to log.
+type ServicesLoader func(ctx context.Context, log io.Writer, settings config.Settings) (Services, error)
+
+type App struct {
+	stdout          io.Writer
+	stderr          io.Writer
+	settingsLoader  SettingsLoader
+	servicesLoader  ServicesLoader
+	settingsOptions SettingsOptions
+	settings        *config.Settings
+	services        *Services
+	verbose         bool
+	output          formatFlag
+	columns         columnsFlag
+}
+
+func NewApp(stdout io.Writer, stderr io.Writer, settingsLoader SettingsLoader, servicesLoader ServicesLoader) *App {
+	return &App{
+		stdout:          stdout,
+		stderr:          stderr,
+		settingsLoader:  settingsLoader,
+		servicesLoader:  servicesLoader,
+		settingsOptions: SettingsOptions{Overrides: map[string]string{}},
+		output:          formatFlag{format: render.FormatJSON},
+	}
+}
+
+// Run executes the command line args, without the program name, and returns the exit code of the process.
+func (a *App) Run(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet(AppName, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	a.globalFlags(fs)
+	root := a.rootCommand()
+	fs.Usage = func() {
+		root.printUsage(AppName, a.stderr)
+		_, _ = fmt.Fprintln(a.stderr, "\nGlobal flags:")
+		fs.SetOutput(a.stderr)
+		fs.PrintDefaults()
+	}
+	err := fs.Parse(args)
+	if err == nil {
+		err = root.execute(ctx, "", fs.Args(), a.stderr, a.globalFlags)
+	} else if !errors.Is(err, flag.ErrHelp) {
+		err = UsageError{Message: err.Error()}
+	}
+	if err != nil && !errors.Is(err, flag.ErrHelp) {
+		// errors wrapped along the way may quote secrets, so they are redacted once, here
+		_, _ = fmt.Fprintf(a.stderr, "Error: %s\n", commons.Redact(err.Error()))
+	}
+	return ExitCode(err)
+}
+
+// ExitCode maps err to the exit code reported for it.
+func ExitCode(err error) int {
+	var usageErr UsageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return ExitOK
+	case errors.As(err, &usageErr), errors.Is(err, commons.ErrInvalidMarket), errors.Is(err, commons.ErrInvalidReference):
+		return ExitUsage
+	case errors.Is(err, commons.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, commons.ErrUnauthorized), errors.Is(err, commons.ErrForbidden):
+		return ExitAuth
+	case errors.Is(err, commons.ErrRateLimited):
+		return ExitRateLimited
+	case errors.Is(err, commons.ErrServerError), commons.IsRetryable(err):
+		return ExitUnavailable
+	default:
+		return ExitFailure
+	}
+}
+
+func (a *App) globalFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&a.verbose, "verbose", a.verbose, "report what is being loaded to stderr")
+	outputUsage := "output format: " + strings.Join(lo.Map(render.Formats, func(f render.Format, _ int) string {
+		return f.String()
+	}), ", ")
+	fs.Var(&a.output, "output", outputUsage)
+	fs.Var(&a.output, "o", "shorthand for --output")
+	fs.Var(&a.columns, "columns", "fields shown by table and csv output, separated by commas, e.g. name,artists.name")
+	a.settingsFlags(fs)
+}
+
+func (a *App) rootCommand() *Command {
+	return &Command{
+		Name:    AppName,
+		Summary: "Query the Spotify catalog from the command line.",
+		Commands: []*Command{
+			a.artistCommand(),
+			a.albumCommand(),
+			a.trackCommand(),
+			a.configCommand(),
+		},
+	}
+}
+
+func (a *App) loadServices(ctx context.Context) (Services, error) {
+	// the output is checked first, so a wrong flag doesn't cost any request
+	if _, err := a.renderer(); err != nil {
+		return Services{}, err
+	}
+	if a.services != nil {
+		return *a.services, nil
+	}
+	settings, err := a.loadSettings()
+	if err != nil {
+		return Services{}, err
+	}
+	services, err := a.servicesLoader(ctx, a.log(), settings)
+	if err != nil {
+		return Services{}, err
+	}
+	a.services = &services
+	return services, nil
+}
+
+func (a *App) loadSettings() (config.Settings, error) {
+	if a.settings != nil {
+		return *a.settings, nil
+	}
+	settings, err := a.settingsLoader(a.log(), a.settingsOptions)
+	if err != nil {
+		return config.Settings{}, err
+	}
+	a.settings = &settings
+	return settings, nil
+}
+
+func (a *App) log() io.Writer {
+	if a.verbose {
+		return a.stderr
+	}
+	return io.Discard
+}
+
+func (a *App) renderer() (render.Renderer, error) {
+	renderer, err := render.NewRenderer(a.output.format, a.columns)
+	if err != nil {
+		return nil, UsageError{Message: err.Error()}
+	}
+	return renderer, nil
+}
+
+// print writes the result to stdout. A failed call may still answer partial results, e.g. the items found when some
+// of the requested IDs are missing, which are printed before the error is reported.
+func (a *App) print(result any, err error) error {
+	if result != nil {
+		renderer, rErr := a.renderer()
+		if rErr != nil {
+			return rErr
+		}
+		if rErr = renderer.Render(a.stdout, result); rErr != nil {
+			return fmt.Errorf("error writing output - %w", rErr)
+		}
+	}
+	return err
+}
+
+// printAll writes the items of a paginated sequence. Renderers that write items on their own, like ndjson, write each
+// one as soon as it is fetched; the rest write them all once the sequence ends or fails.
+func printAll[T any](a *App, seq iter.Seq2[T, error]) error {
+	renderer, err := a.renderer()
+	if err != nil {
+		return err
+	}
+	itemRenderer, streams := renderer.(render.ItemRenderer)
+	items := []T{}
+	for item, sErr := range seq {
+		if sErr != nil {
+			err = sErr
+			break
+		}
+		if !streams {
+			items = append(items, item)
+			continue
+		}
+		if rErr := itemRenderer.RenderItem(a.stdout, item); rErr != nil {
+			return fmt.Errorf("error writing output - %w", rErr)
+		}
+	}
+	if streams {
+		return err
+	}
+	return a.print(items, err)
+}
+
+// printFound writes the items of a multiple IDs request that were found. They are only written when every ID was found
+// or the only failure is that some were missing, so a failed request doesn't print an empty list.
+func printFound[T any](a *App, items []T, found func(item T) bool, err error) error {
+	var missingErr commons.MissingIDsError
+	if err != nil && !errors.As(err, &missingErr) {
+		return err
+	}
+	return a.print(lo.Filter(items, func(item T, _ int) bool {
+		return found(item)
+	}), err)
+}
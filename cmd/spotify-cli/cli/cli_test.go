@@ -0,0 +1,320 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/config"
+	servicemocks "jezz-go-spotify-integration/internal/mocks/service"
+	"jezz-go-spotify-integration/internal/model"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+const testArtistID = "0k17h0D3J5VfsdmQ1iZtE9"
+
+func newTestApp(services Services) (*App, *bytes.Buffer, *bytes.Buffer, *int) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	loads := 0
+	app := NewApp(stdout, stderr, loadTestSettings, func(_ context.Context, _ io.Writer, _ config.Settings) (Services, error) {
+		loads++
+		return services, nil
+	})
+	return app, stdout, stderr, &loads
+}
+
+func loadTestSettings(_ io.Writer, options SettingsOptions) (config.Settings, error) {
+	settings := config.Settings{
+		AppConfig: config.AppConfig{
+			Client: config.CliConfig{BaseURL: "https://api.spotify.com", AccountsURL: "https://accounts.spotify.com"},
+		},
+		Credentials: config.CliCredentials{ID: "dummy-client-id", Secret: "dummy-client-secret"},
+	}
+	for path, value := range options.Overrides {
+		if err := config.SetPath(&settings, path, value); err != nil {
+			return config.Settings{}, err
+		}
+	}
+	return settings, nil
+}
+
+func TestApp_Run_Usage(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStderr string
+	}{
+		{name: "should require a command", args: nil, wantCode: ExitUsage, wantStderr: "requires a subcommand"},
+		{name: "should reject unknown commands", args: []string{"playlist"}, wantCode: ExitUsage, wantStderr: `unknown command "playlist"`},
+		{name: "should reject unknown subcommands", args: []string{"artist", "related"}, wantCode: ExitUsage, wantStderr: `unknown command "related" for spotify-cli artist`},
+		{name: "should require the artist", args: []string{"artist", "get"}, wantCode: ExitUsage, wantStderr: "missing artist"},
+		{name: "should reject extra arguments", args: []string{"artist", "albums", testArtistID, "other"}, wantCode: ExitUsage, wantStderr: "unexpected arguments"},
+		{name: "should reject unknown flags", args: []string{"track", "get", "--limit", "2", testArtistID}, wantCode: ExitUsage, wantStderr: "flag provided but not defined: -limit"},
+		{name: "should reject negative limits", args: []string{"album", "new-releases", "--limit", "-1"}, wantCode: ExitUsage, wantStderr: "must not be negative"},
+		{name: "should reject unknown album groups", args: []string{"artist", "albums", "--groups", "album,live", testArtistID}, wantCode: ExitUsage, wantStderr: `unknown album group "live"`},
+		{name: "should reject unknown output formats", args: []string{"-o", "xml", "track", "get", testArtistID}, wantCode: ExitUsage, wantStderr: `unknown output format "xml"`},
+		{name: "should reject columns for json output", args: []string{"track", "get", testArtistID, "--columns", "name"}, wantCode: ExitUsage, wantStderr: "columns can only be selected for table and csv output"},
+		{name: "should reject paging all items from an offset", args: []string{"album", "new-releases", "--all", "--offset", "5"}, wantCode: ExitUsage, wantStderr: "--all can't be combined"},
+		{name: "should print the command help", args: []string{"album", "tracks", "--help"}, wantCode: ExitOK, wantStderr: "Usage: spotify-cli album tracks [flags] <album>"},
+		{name: "should print the commands help", args: []string{"--help"}, wantCode: ExitOK, wantStderr: "Global flags:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, stdout, stderr, loads := newTestApp(Services{})
+
+			code := app.Run(context.Background(), tt.args)
+
+			if code != tt.wantCode {
+				t.Errorf("Run() = %d, want %d", code, tt.wantCode)
+			}
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("Run() stderr = %q, want it to contain %q", stderr.String(), tt.wantStderr)
+			}
+			if stdout.Len() != 0 || *loads != 0 {
+				t.Errorf("Run() stdout = %q, loads = %d, want nothing run", stdout.String(), *loads)
+			}
+		})
+	}
+}
+
+func TestApp_Run_ArtistAlbums(t *testing.T) {
+	artists := servicemocks.NewArtistsService(t)
+	artists.On("GetArtistAlbums", mock.Anything, lo.ToPtr("BR"), &[]string{"single", "compilation"}, lo.ToPtr(5), lo.ToPtr(10), testArtistID).
+		Return(model.SimplifiedArtistAlbumsPaginated{Pagination: model.Pagination{Total: 42}}, nil).Once()
+	app, stdout, stderr, _ := newTestApp(Services{Artists: artists})
+
+	code := app.Run(context.Background(), []string{"artist", "albums", testArtistID, "--market", "BR", "--groups", "single", "--groups", "compilation,single", "--limit=5", "--offset", "10"})
+
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, want %d, stderr %q", code, ExitOK, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), `"total": 42`) {
+		t.Errorf("Run() stdout = %q, want the albums page", stdout.String())
+	}
+}
+
+func TestApp_Run_Output(t *testing.T) {
+	tracks := []model.SimplifiedTrack{
+		{ID: "4vLYewWIvqHfKtJDk8c8tq", Name: "So What", Artists: []model.SimplifiedArtist{{Name: "Miles Davis"}}},
+		{ID: "7q3kkfAVpmcZ8g6JUThi3o", Name: "Freddie Freeloader", Artists: []model.SimplifiedArtist{{Name: "Miles Davis"}}},
+	}
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "should stream every item as ndjson",
+			args: []string{"album", "tracks", "1weenld61qoidwYuZ1GESA", "--all", "--max", "2", "--output", "ndjson"},
+			want: "{\"artists\":[{\"external_urls\":{\"spotify\":\"\"},\"href\":\"\",\"id\":\"\",\"name\":\"Miles Davis\",\"type\":\"\",\"uri\":\"\"}],",
+		},
+		{
+			name: "should write every item as a table with the selected columns",
+			args: []string{"-o", "table", "album", "tracks", "1weenld61qoidwYuZ1GESA", "--all", "--max=2", "--columns", "name,artists.name"},
+			want: "NAME                ARTISTS.NAME\nSo What             Miles Davis\nFreddie Freeloader  Miles Davis\n",
+		},
+		{
+			name: "should write every item as csv",
+			args: []string{"album", "tracks", "1weenld61qoidwYuZ1GESA", "--all", "--max", "2", "-o", "csv", "--columns", "id"},
+			want: "id\n4vLYewWIvqHfKtJDk8c8tq\n7q3kkfAVpmcZ8g6JUThi3o\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			albums := servicemocks.NewAlbumsService(t)
+			albums.On("AlbumTracksSeq", mock.Anything, (*string)(nil), 2, "1weenld61qoidwYuZ1GESA").
+				Return(iter.Seq2[model.SimplifiedTrack, error](func(yield func(model.SimplifiedTrack, error) bool) {
+					for _, track := range tracks {
+						if !yield(track, nil) {
+							return
+						}
+					}
+				})).Once()
+			app, stdout, stderr, _ := newTestApp(Services{Albums: albums})
+
+			code := app.Run(context.Background(), tt.args)
+
+			if code != ExitOK {
+				t.Fatalf("Run() = %d, want %d, stderr %q", code, ExitOK, stderr.String())
+			}
+			if !strings.HasPrefix(stdout.String(), tt.want) {
+				t.Errorf("Run() stdout = %q, want it to start with %q", stdout.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestApp_Run_PartialResults(t *testing.T) {
+	ids := []string{"4jvurVXLanQyP1rPZjbSln", "0lw68yx3MhKflWFqCsGkIs"}
+	albums := servicemocks.NewAlbumsService(t)
+	albums.On("GetAlbums", mock.Anything, (*string)(nil), ids[0], ids[1]).
+		Return([]model.Album{{SimplifiedAlbum: model.SimplifiedAlbum{ID: model.ID(ids[0])}}, {}},
+			commons.MissingIDsError{Resource: "album", IDs: ids[1:]}).Once()
+	app, stdout, stderr, _ := newTestApp(Services{Albums: albums})
+
+	code := app.Run(context.Background(), append([]string{"album", "get"}, ids...))
+
+	if code != ExitNotFound {
+		t.Errorf("Run() = %d, want %d", code, ExitNotFound)
+	}
+	if !strings.Contains(stdout.String(), ids[0]) || strings.Contains(stdout.String(), ids[1]) {
+		t.Errorf("Run() stdout = %q, want only the album found", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), ids[1]) {
+		t.Errorf("Run() stderr = %q, want the missing album reported", stderr.String())
+	}
+}
+
+func TestApp_Run_MultipleIDsError(t *testing.T) {
+	ids := []string{"3O5JIwSON3KBaoyMUsjLjn", "0lw68yx3MhKflWFqCsGkIs"}
+	tracks := servicemocks.NewTracksService(t)
+	tracks.On("GetTracks", mock.Anything, (*string)(nil), ids[0], ids[1]).
+		Return([]model.Track{{}, {}}, &commons.ResourceError{Status: 503, Message: "Service unavailable"}).Once()
+	app, stdout, stderr, _ := newTestApp(Services{Tracks: tracks})
+
+	code := app.Run(context.Background(), append([]string{"track", "get"}, ids...))
+
+	if code != ExitUnavailable {
+		t.Errorf("Run() = %d, want %d", code, ExitUnavailable)
+	}
+	if stdout.Len() != 0 || !strings.Contains(stderr.String(), "Service unavailable") {
+		t.Errorf("Run() stdout = %q, stderr = %q, want the error on stderr only", stdout.String(), stderr.String())
+	}
+}
+
+func TestApp_Run_LoaderError(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	app := NewApp(stdout, stderr, loadTestSettings, func(_ context.Context, _ io.Writer, _ config.Settings) (Services, error) {
+		return Services{}, fmt.Errorf("error authenticating - %w", commons.AppError{Status: 400, Cause: commons.ErrUnauthorized})
+	})
+
+	code := app.Run(context.Background(), []string{"track", "get", "3O5JIwSON3KBaoyMUsjLjn"})
+
+	if code != ExitAuth {
+		t.Errorf("Run() = %d, want %d", code, ExitAuth)
+	}
+	if !strings.HasPrefix(stderr.String(), "Error: error authenticating") || stdout.Len() != 0 {
+		t.Errorf("Run() stdout = %q, stderr = %q, want the error on stderr only", stdout.String(), stderr.String())
+	}
+}
+
+func TestApp_Run_ConfigShow(t *testing.T) {
+	app, stdout, stderr, loads := newTestApp(Services{})
+
+	code := app.Run(context.Background(), []string{"config", "show", "-o", "yaml", "--base-url", "http://localhost:8080", "--strict-decoding"})
+
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %q, want %d", code, stderr.String(), ExitOK)
+	}
+	for _, want := range []string{"base_url: http://localhost:8080", "strict_decoding: true", "client_id: dummy-client-id", "client_secret: " + config.RedactedValue} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("Run() stdout = %q, want it to contain %q", stdout.String(), want)
+		}
+	}
+	if strings.Contains(stdout.String(), "dummy-client-secret") || *loads != 0 {
+		t.Errorf("Run() stdout = %q, loads = %d, want the secret redacted and no service loaded", stdout.String(), *loads)
+	}
+}
+
+func TestApp_Run_ConfigShowKeepsReferences(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	var gotOptions SettingsOptions
+	app := NewApp(stdout, stderr, func(log io.Writer, options SettingsOptions) (config.Settings, error) {
+		gotOptions = options
+		settings, err := loadTestSettings(log, options)
+		settings.Credentials.ID = "exec:pass show spotify/id"
+		return settings, err
+	}, nil)
+
+	code := app.Run(context.Background(), []string{"config", "show", "-o", "yaml"})
+
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %q, want %d", code, stderr.String(), ExitOK)
+	}
+	if !gotOptions.KeepReferences || !strings.Contains(stdout.String(), "client_id: exec:pass show spotify/id") {
+		t.Errorf("Run() stdout = %q, options = %+v, want the references left unresolved", stdout.String(), gotOptions)
+	}
+}
+
+func TestApp_Run_RedactsErrors(t *testing.T) {
+	commons.RegisterSecret("DUMMY_WRAPPED_CLIENT_SECRET")
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	app := NewApp(stdout, stderr, loadTestSettings, func(_ context.Context, _ io.Writer, _ config.Settings) (Services, error) {
+		return Services{}, fmt.Errorf("error authenticating - invalid client secret %q", "DUMMY_WRAPPED_CLIENT_SECRET")
+	})
+
+	app.Run(context.Background(), []string{"track", "get", "3O5JIwSON3KBaoyMUsjLjn"})
+
+	if strings.Contains(stderr.String(), "DUMMY_WRAPPED_CLIENT_SECRET") || !strings.Contains(stderr.String(), commons.RedactedValue) {
+		t.Errorf("Run() stderr = %q, want the secret redacted", stderr.String())
+	}
+}
+
+func TestApp_Run_ProfileMarket(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantMarket string
+	}{
+		{name: "should use the market of the settings when none is given", args: nil, wantMarket: "SE"},
+		{name: "should prefer the market given to the command", args: []string{"--market", "BR"}, wantMarket: "BR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artists := servicemocks.NewArtistsService(t)
+			artists.On("GetArtistTopTracks", mock.Anything, lo.ToPtr(tt.wantMarket), testArtistID).
+				Return([]model.Track{}, nil).Once()
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			var gotOptions SettingsOptions
+			app := NewApp(stdout, stderr, func(log io.Writer, options SettingsOptions) (config.Settings, error) {
+				gotOptions = options
+				settings, err := loadTestSettings(log, options)
+				settings.Profile, settings.Market = options.Profile, "SE"
+				return settings, err
+			}, func(_ context.Context, _ io.Writer, _ config.Settings) (Services, error) {
+				return Services{Artists: artists}, nil
+			})
+
+			code := app.Run(context.Background(), append([]string{"--profile", "staging", "artist", "top-tracks", testArtistID}, tt.args...))
+
+			if code != ExitOK {
+				t.Fatalf("Run() = %d, stderr = %q, want %d", code, stderr.String(), ExitOK)
+			}
+			if gotOptions.Profile != "staging" {
+				t.Errorf("Run() profile = %q, want staging", gotOptions.Profile)
+			}
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "should succeed without error", err: nil, want: ExitOK},
+		{name: "should fail with usage on invalid market", err: commons.InvalidMarketError{Input: "Narnia"}, want: ExitUsage},
+		{name: "should fail with usage on invalid reference", err: fmt.Errorf("error getting album - %w", commons.InvalidReferenceError{Input: "x"}), want: ExitUsage},
+		{name: "should fail with not found", err: &commons.ResourceError{Status: 404}, want: ExitNotFound},
+		{name: "should fail with auth on forbidden", err: commons.ResourceError{Status: 403}, want: ExitAuth},
+		{name: "should fail with rate limited", err: commons.ResourceError{Status: 429}, want: ExitRateLimited},
+		{name: "should fail with unavailable on server errors", err: commons.ResourceError{Status: 503}, want: ExitUnavailable},
+		{name: "should fail generically otherwise", err: errors.New("boom"), want: ExitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Command is a node of the command tree. Commands with subcommands only dispatch to them, leaf commands parse
+// their flags and arguments and run.
+type Command struct {
+	Name     string
+	Args     string
+	Summary  string
+	Flags    func(fs *flag.FlagSet)
+	Run      func(ctx context.Context, args []string) error
+	Commands []*Command
+}
+
+// UsageError is a command line that can't be run as given, e.g. an unknown command or a missing argument.
+type UsageError struct {
+	Message string
+}
+
+func (e UsageError) Error() string {
+	return e.Message
+}
+
+func usageErrorf(format string, args ...any) error {
+	return UsageError{Message: fmt.Sprintf(format, args...)}
+}
+
+// execute runs the command args select. Global flags are accepted by every leaf command, so they may be given
+// anywhere in the command line.
+func (c *Command) execute(ctx context.Context, path string, args []string, stderr io.Writer, globalFlags func(fs *flag.FlagSet)) error {
+	path = strings.TrimSpace(path + " " + c.Name)
+	if len(c.Commands) > 0 {
+		if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+			c.printUsage(path, stderr)
+			if len(args) == 0 {
+				return usageErrorf("%s requires a subcommand", path)
+			}
+			return flag.ErrHelp
+		}
+		for _, sub := range c.Commands {
+			if sub.Name == args[0] {
+				return sub.execute(ctx, path, args[1:], stderr, globalFlags)
+			}
+		}
+		c.printUsage(path, stderr)
+		return usageErrorf("unknown command %q for %s", args[0], path)
+	}
+
+	// flag errors are reported along with the rest of the errors, only the usage is printed here
+	fs := flag.NewFlagSet(path, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	if c.Flags != nil {
+		c.Flags(fs)
+	}
+	if globalFlags != nil {
+		globalFlags(fs)
+	}
+	fs.Usage = func() {
+		c.printFlagsUsage(fs, stderr)
+	}
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return UsageError{Message: err.Error()}
+	}
+	return c.Run(ctx, positional)
+}
+
+// parseInterspersed parses flags placed before, after or between the positional arguments. Everything after "--" is
+// taken as positional.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		if len(args) > len(rest) && args[len(args)-len(rest)-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+func (c *Command) printUsage(path string, w io.Writer) {
+	_, _ = fmt.Fprintf(w, "Usage: %s <command> [flags] [arguments]\n\n", path)
+	if c.Summary != "" {
+		_, _ = fmt.Fprintf(w, "%s\n\n", c.Summary)
+	}
+	_, _ = fmt.Fprintln(w, "Commands:")
+	for _, sub := range c.Commands {
+		_, _ = fmt.Fprintf(w, "  %-14s %s\n", sub.Name, sub.Summary)
+	}
+	_, _ = fmt.Fprintf(w, "\nRun '%s <command> --help' for more information on a command.\n", path)
+}
+
+func (c *Command) printFlagsUsage(fs *flag.FlagSet, w io.Writer) {
+	_, _ = fmt.Fprintf(w, "Usage: %s [flags] %s\n\n%s\n", fs.Name(), c.Args, c.Summary)
+	hasFlags := false
+	fs.VisitAll(func(*flag.Flag) {
+		hasFlags = true
+	})
+	if hasFlags {
+		_, _ = fmt.Fprintln(w, "\nFlags:")
+		fs.SetOutput(w)
+		fs.PrintDefaults()
+	}
+}
+
+// requireArgs checks the number of positional arguments, max being negative when any number above min is accepted.
+func requireArgs(args []string, minArgs int, maxArgs int, name string) error {
+	switch {
+	case len(args) < minArgs:
+		return usageErrorf("missing %s", name)
+	case maxArgs >= 0 && len(args) > maxArgs:
+		return usageErrorf("unexpected arguments %q", args[maxArgs:])
+	default:
+		return nil
+	}
+}
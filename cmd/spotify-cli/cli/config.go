@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"io"
+	"jezz-go-spotify-integration/internal/config"
+)
+
+// SettingsOptions are the command line flags that change where the settings are loaded from or override them.
+type SettingsOptions struct {
+	// ConfigPath is the config file given with --config, empty for the default one
+	ConfigPath string
+	// Profile is the profile given with --profile, empty for the default one
+	Profile string
+	// Overrides holds the values of the setting flags that were given, by their config path such as "client.base_url"
+	Overrides map[string]string
+	// KeepReferences leaves references such as exec:command unresolved, for commands that only show the settings
+	KeepReferences bool
+}
+
+// SettingsLoader merges the settings from their sources, the command line flags last. Progress is reported to log.
+type SettingsLoader func(log io.Writer, options SettingsOptions) (config.Settings, error)
+
+// settingFlag records its value as an override of the setting at path, only when the flag is given.
+type settingFlag struct {
+	path      string
+	overrides map[string]string
+	isBool    bool
+}
+
+func (f *settingFlag) String() string {
+	if f == nil || f.overrides == nil {
+		return ""
+	}
+	return f.overrides[f.path]
+}
+
+func (f *settingFlag) Set(s string) error {
+	f.overrides[f.path] = s
+	return nil
+}
+
+func (f *settingFlag) IsBoolFlag() bool {
+	return f.isBool
+}
+
+func (a *App) settingsFlags(fs *flag.FlagSet) {
+	fs.StringVar(&a.settingsOptions.ConfigPath, "config", a.settingsOptions.ConfigPath, "config file, instead of the one in the user config dir")
+	fs.StringVar(&a.settingsOptions.Profile, "profile", a.settingsOptions.Profile, "config profile to apply, instead of the default one")
+	settingFlags := []struct {
+		name   string
+		path   string
+		usage  string
+		isBool bool
+	}{
+		{name: "base-url", path: "client.base_url", usage: "Spotify Web API url"},
+		{name: "accounts-url", path: "client.accounts_url", usage: "Spotify accounts url"},
+		{name: "strict-decoding", path: "client.strict_decoding", usage: "fail on response fields the model doesn't map", isBool: true},
+		{name: "client-id", path: "credentials.client_id", usage: "Spotify app client ID"},
+		{name: "default-market", path: "market", usage: "market of the commands run without --market"},
+	}
+	for _, setting := range settingFlags {
+		fs.Var(&settingFlag{path: setting.path, overrides: a.settingsOptions.Overrides, isBool: setting.isBool}, setting.name, setting.usage)
+	}
+}
+
+func (a *App) configCommand() *Command {
+	return &Command{
+		Name:    "config",
+		Summary: "Inspect the config",
+		Commands: []*Command{
+			a.configShowCommand(),
+		},
+	}
+}
+
+func (a *App) configShowCommand() *Command {
+	return &Command{
+		Name:    "show",
+		Summary: "Show the effective config, merged from every source, with references unresolved and secrets redacted",
+		Run: func(_ context.Context, args []string) error {
+			if err := requireArgs(args, 0, 0, ""); err != nil {
+				return err
+			}
+			if _, err := a.renderer(); err != nil {
+				return err
+			}
+			// the settings are only shown, so references aren't resolved, which could run commands
+			options := a.settingsOptions
+			options.KeepReferences = true
+			settings, err := a.settingsLoader(a.log(), options)
+			if err != nil {
+				return err
+			}
+			return a.print(config.Redacted(settings), nil)
+		},
+	}
+}
@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/render"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// optionalInt is an int flag that stays nil when it isn't given, so the API default applies.
+type optionalInt struct {
+	value *int
+}
+
+func (f *optionalInt) String() string {
+	if f == nil || f.value == nil {
+		return ""
+	}
+	return strconv.Itoa(*f.value)
+}
+
+func (f *optionalInt) Set(s string) error {
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("%q is not a number", s)
+	}
+	if value < 0 {
+		return fmt.Errorf("%d must not be negative", value)
+	}
+	f.value = &value
+	return nil
+}
+
+// optionalString is a string flag that stays nil when it isn't given.
+type optionalString struct {
+	value *string
+}
+
+func (f *optionalString) String() string {
+	if f == nil || f.value == nil {
+		return ""
+	}
+	return *f.value
+}
+
+func (f *optionalString) Set(s string) error {
+	f.value = &s
+	return nil
+}
+
+var albumGroups = []model.AlbumGroup{
+	model.AlbumGroupAlbum,
+	model.AlbumGroupSingle,
+	model.AlbumGroupAppearsOn,
+	model.AlbumGroupCompilation,
+}
+
+// albumGroupsFlag takes album groups separated by commas and may be repeated.
+type albumGroupsFlag struct {
+	values []string
+}
+
+func (f *albumGroupsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(f.values, ",")
+}
+
+func (f *albumGroupsFlag) Set(s string) error {
+	for _, group := range strings.Split(s, ",") {
+		group = strings.ToLower(strings.TrimSpace(group))
+		if !slices.Contains(albumGroups, model.AlbumGroup(group)) {
+			return fmt.Errorf("unknown album group %q, expected any of %s", group, model.AlbumGroups(albumGroups).String())
+		}
+		if !slices.Contains(f.values, group) {
+			f.values = append(f.values, group)
+		}
+	}
+	return nil
+}
+
+func (f *albumGroupsFlag) get() *[]string {
+	if len(f.values) == 0 {
+		return nil
+	}
+	return lo.ToPtr(slices.Clone(f.values))
+}
+
+type formatFlag struct {
+	format render.Format
+}
+
+func (f *formatFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.format.String()
+}
+
+func (f *formatFlag) Set(s string) error {
+	format, err := render.ParseFormat(s)
+	if err != nil {
+		return err
+	}
+	f.format = format
+	return nil
+}
+
+// columnsFlag takes column paths separated by commas and may be repeated.
+type columnsFlag []string
+
+func (f *columnsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(*f, ",")
+}
+
+func (f *columnsFlag) Set(s string) error {
+	for _, column := range strings.Split(s, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			*f = append(*f, column)
+		}
+	}
+	return nil
+}
+
+// allItemsFlags fetch every page of a paginated command instead of the one limit and offset select.
+type allItemsFlags struct {
+	all      bool
+	maxItems int
+}
+
+func (f *allItemsFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&f.all, "all", false, "fetch every page instead of a single one")
+	fs.IntVar(&f.maxItems, "max", 0, "maximum number of items fetched with --all, 0 for no maximum")
+}
+
+func (f *allItemsFlags) validate(limit *optionalInt, offset *optionalInt) error {
+	if f.maxItems < 0 {
+		return usageErrorf("--max must not be negative")
+	}
+	if f.all && (limit.value != nil || offset.value != nil) {
+		return usageErrorf("--all can't be combined with --limit or --offset")
+	}
+	if !f.all && f.maxItems > 0 {
+		return usageErrorf("--max requires --all")
+	}
+	return nil
+}
+
+func marketFlag(fs *flag.FlagSet, market *optionalString) {
+	fs.Var(market, "market", "market as a country name, ISO 3166-1 alpha-2 or alpha-3 code, or from_token")
+}
+
+// market returns the market given with --market, else the market of the settings, if any.
+func (a *App) market(market *optionalString) *string {
+	if market.value != nil || a.settings == nil || a.settings.Market == "" {
+		return market.value
+	}
+	return lo.ToPtr(a.settings.Market)
+}
+
+func paginationFlags(fs *flag.FlagSet, limit *optionalInt, offset *optionalInt) {
+	fs.Var(limit, "limit", "maximum number of items to return")
+	fs.Var(offset, "offset", "index of the first item to return")
+}
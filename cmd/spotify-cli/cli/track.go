@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"jezz-go-spotify-integration/internal/model"
+)
+
+func (a *App) trackCommand() *Command {
+	return &Command{
+		Name:    "track",
+		Summary: "Get tracks",
+		Commands: []*Command{
+			a.trackGetCommand(),
+		},
+	}
+}
+
+func (a *App) trackGetCommand() *Command {
+	var market optionalString
+	return &Command{
+		Name:    "get",
+		Args:    "<track>...",
+		Summary: "Get one or more tracks by ID, Spotify URI or share link",
+		Flags: func(fs *flag.FlagSet) {
+			marketFlag(fs, &market)
+		},
+		Run: func(ctx context.Context, args []string) error {
+			if err := requireArgs(args, 1, -1, "track"); err != nil {
+				return err
+			}
+			services, err := a.loadServices(ctx)
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				track, gErr := services.Tracks.GetTrack(ctx, a.market(&market), args[0])
+				if gErr != nil {
+					return gErr
+				}
+				return a.print(track, nil)
+			}
+			tracks, err := services.Tracks.GetTracks(ctx, a.market(&market), args...)
+			return printFound(a, tracks, func(track model.Track) bool {
+				return track.ID != ""
+			}, err)
+		},
+	}
+}
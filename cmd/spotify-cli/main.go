@@ -1,13 +1,26 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
-	"jezz-go-spotify-integration/cmd/spotify-cli/sample"
+	"io"
+	"jezz-go-spotify-integration/cmd/spotify-cli/cli"
 	"jezz-go-spotify-integration/internal/auth"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/config"
+	"jezz-go-spotify-integration/internal/model"
 	"jezz-go-spotify-integration/internal/service"
+	"jezz-go-spotify-integration/internal/utils"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	defaultUserConfigFile  = "spotify-cli/config.yml"
+	defaultUserSessionFile = "spotify-cli/session.json"
+	profileEnv             = "SPOTIFY_PROFILE"
 )
 
 //go:embed config/config.yml
@@ -17,110 +30,193 @@ var appConfigData []byte
 var spotifyCliCredentialsData []byte
 
 func main() {
-	appCfg, cliCredCfg, err := loadConfigs()
+	app := cli.NewApp(os.Stdout, os.Stderr, loadSettings, loadCliServices)
+	os.Exit(app.Run(context.Background(), os.Args[1:]))
+}
+
+func loadCliServices(ctx context.Context, log io.Writer, settings config.Settings) (cli.Services, error) {
+	httpAPIClient := loadHTTPClients(log, settings.Client, settings.HTTP)
+	authService, err := loadAuthService(ctx, log, settings.AppConfig, settings.Credentials)
 	if err != nil {
-		return
+		return cli.Services{}, err
 	}
-	httpAPIClient := loadHTTPClients()
-	authService := loadAuthService(appCfg, cliCredCfg)
-	artistsSvc, albumSvc, tracksSvc := loadServices(appCfg, httpAPIClient, authService)
-
-	sample.RunAppSampleCalls(artistsSvc, albumSvc, tracksSvc)
+	marketResolver := newMarketResolver(log, settings.Client, httpAPIClient, authService)
+	return loadServices(log, settings.AppConfig, httpAPIClient, authService, marketResolver), nil
 }
 
-func loadConfigs() (config.AppConfig, config.CliCredentials, error) {
-	fmt.Println("Loading app configs...")
-	appCfgLoader := NewAppConfigLoader()
-	appCfg, err := appCfgLoader.Load(appConfigData)
+func loadSettings(log io.Writer, options cli.SettingsOptions) (config.Settings, error) {
+	_, _ = fmt.Fprintln(log, "Loading configs...")
+	settingsLoader, err := NewSettingsLoader(options)
 	if err != nil {
-		fmt.Println("✖ Error loading configs :(")
-		fmt.Printf("╰┈➤%s\n\n", err.Error())
-		return config.AppConfig{}, config.CliCredentials{}, err
+		_, _ = fmt.Fprintln(log, "✖ Error loading configs :(")
+		return config.Settings{}, err
 	}
-	fmt.Printf("✔ App configs loaded! :)\n\n")
-
-	fmt.Println("Loading client credentials configs...")
-	cliCredCfgLoader := NewCliCredentialsLoader()
-	cliCredCfg, err := cliCredCfgLoader.Load(spotifyCliCredentialsData)
+	settings, err := settingsLoader.Load(appConfigData)
 	if err != nil {
-		fmt.Println("✖ Error loading client credentials configs :(")
-		fmt.Printf("╰┈➤%s\n\n", err.Error())
-		return config.AppConfig{}, config.CliCredentials{}, err
+		_, _ = fmt.Fprintln(log, "✖ Error loading configs :(")
+		return config.Settings{}, fmt.Errorf("error loading configs - %w", err)
 	}
-	fmt.Printf("✔ Client credentials configs loaded! :)\n\n")
-
-	return appCfg, cliCredCfg, nil
-}
-
-func NewAppConfigLoader() config.Loader[config.AppConfig] {
-	return config.AppConfigLoader{}
+	_, _ = fmt.Fprintf(log, "✔ Configs loaded! :)\n\n")
+	return settings, nil
 }
 
-func NewCliCredentialsLoader() config.Loader[config.CliCredentials] {
-	return config.CliCredentialsConfigLoader{}
+// NewSettingsLoader merges, from lowest to highest precedence, the embedded config and client credentials, the user
+// config file, the selected profile, the environment and the command line flags. The profile is the one given with
+// --profile, else in SPOTIFY_PROFILE, else the default profile of the config. References are resolved unless
+// options.KeepReferences is set.
+func NewSettingsLoader(options cli.SettingsOptions) (config.Loader[config.Settings], error) {
+	profile := options.Profile
+	if profile == "" {
+		profile = os.Getenv(profileEnv)
+	}
+	userConfigLayer := config.FileLayer[config.Settings](options.ConfigPath)
+	if options.ConfigPath == "" {
+		userConfigDir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, fmt.Errorf("error resolving user config path - %w", err)
+		}
+		userConfigLayer = config.OptionalFileLayer[config.Settings](filepath.Join(userConfigDir, defaultUserConfigFile))
+	}
+	loader := config.NewLayeredLoader(
+		config.SectionLayer[config.Settings]("embedded client credentials", "credentials", spotifyCliCredentialsData),
+		userConfigLayer,
+		config.ProfileLayer(profile),
+		config.EnvLayer[config.Settings](os.LookupEnv),
+		config.ValuesLayer[config.Settings]("flags", options.Overrides),
+	)
+	if options.KeepReferences {
+		return loader.WithoutReferences(), nil
+	}
+	return loader, nil
 }
 
-func NewHTTPApiClient() client.HTTPApiClient {
-	return client.NewCustomHTTPApiClient()
+func NewHTTPApiClient(cliConfig config.CliConfig, httpConfig config.HTTPConfig) client.HTTPApiClient {
+	retryPolicy := client.DefaultRetryPolicy()
+	if httpConfig.MaxAttempts > 0 {
+		retryPolicy.MaxAttempts = httpConfig.MaxAttempts
+	}
+	rateLimitConfig := client.DefaultRateLimitConfig()
+	if httpConfig.RequestsPerSecond > 0 {
+		rateLimitConfig.RequestsPerSecond = httpConfig.RequestsPerSecond
+		rateLimitConfig.MinRequestsPerSecond = min(rateLimitConfig.MinRequestsPerSecond, httpConfig.RequestsPerSecond)
+	}
+	if httpConfig.Burst > 0 {
+		rateLimitConfig.Burst = httpConfig.Burst
+	}
+	return client.NewCustomHTTPApiClient(retryPolicy).
+		WithStrictDecoding(cliConfig.StrictDecoding).
+		WithTimeout(time.Duration(httpConfig.Timeout)).
+		WithRateLimiter(client.NewRateLimiter(rateLimitConfig))
 }
 
-func loadHTTPClients() client.HTTPApiClient {
-	fmt.Println("Loading HTTP API client...")
-	httpClient := NewHTTPApiClient()
-	fmt.Printf("✔ HTTP API client loaded! :)\n\n")
+func loadHTTPClients(log io.Writer, cliConfig config.CliConfig, httpConfig config.HTTPConfig) client.HTTPApiClient {
+	_, _ = fmt.Fprintln(log, "Loading HTTP API client...")
+	httpClient := NewHTTPApiClient(cliConfig, httpConfig)
+	_, _ = fmt.Fprintf(log, "✔ HTTP API client loaded! :)\n\n")
 	return httpClient
 }
 
-func loadAuthService(appCfg config.AppConfig, cliCredCfg config.CliCredentials) *service.SpotifyAuthService {
-	fmt.Println("Loading auth service...")
-	credentialsFlow := auth.NewCliCredentialsFlow(appCfg.Client.AccountsURL, cliCredCfg.ID, cliCredCfg.Secret)
-	authService, err := service.NewSpotifyAuthService(credentialsFlow)
+func loadAuthService(ctx context.Context, log io.Writer, appCfg config.AppConfig, cliCredCfg config.CliCredentials) (*service.SpotifyAuthService, error) {
+	_, _ = fmt.Fprintln(log, "Loading auth service...")
+	authFlow, err := loadAuthFlow(appCfg, cliCredCfg)
 	if err != nil {
-		fmt.Println("✖ Auth service loading failed :(")
-		fmt.Printf("╰┈➤%s\n\n", err.Error())
-		return nil
+		_, _ = fmt.Fprintln(log, "✖ Auth service loading failed :(")
+		return nil, err
 	}
-	fmt.Printf("✔ Auth service loaded! :)\n\n")
-	return authService
+	authService, err := service.NewSpotifyAuthService(ctx, authFlow, service.DefaultTokenRefreshSkew)
+	if err != nil {
+		_, _ = fmt.Fprintln(log, "✖ Auth service loading failed :(")
+		return nil, fmt.Errorf("error authenticating - %w", err)
+	}
+	_, _ = fmt.Fprintf(log, "✔ Auth service loaded! :)\n\n")
+	return authService, nil
+}
+
+func loadAuthFlow(appCfg config.AppConfig, cliCredCfg config.CliCredentials) (auth.AuthenticationFlow, error) {
+	if appCfg.User == nil {
+		return auth.NewCliCredentialsFlow(appCfg.Client.AccountsURL, cliCredCfg.ID, cliCredCfg.Secret), nil
+	}
+
+	tokenStorePath := appCfg.User.TokenStorePath
+	if tokenStorePath == "" {
+		userConfigDir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, fmt.Errorf("error resolving user session path - %w", err)
+		}
+		tokenStorePath = filepath.Join(userConfigDir, defaultUserSessionFile)
+	}
+	pkceFlow := auth.NewAuthorizationCodePKCEFlow(
+		appCfg.Client.AccountsURL,
+		cliCredCfg.ID,
+		appCfg.User.RedirectURL,
+		appCfg.User.Scopes,
+		auth.PrintAuthorizeURL(os.Stderr),
+	)
+	return auth.NewPersistedSessionFlow(pkceFlow, auth.NewFileTokenStore(tokenStorePath), appCfg.User.Scopes), nil
 }
 
-func loadServices(cfg config.AppConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService) (service.ArtistsService, service.AlbumsService, service.TracksService) {
+func loadServices(log io.Writer, cfg config.AppConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService, marketResolver *utils.MarketResolver) cli.Services {
 	cliConfig := cfg.Client
-	artistsSvc := loadArtistsService(cliConfig, httpAPIClient, authService)
-	albumsSvc := loadAlbumsService(cliConfig, httpAPIClient, authService)
-	tracksSvc := loadTracksService(cliConfig, httpAPIClient, authService)
-	return artistsSvc, albumsSvc, tracksSvc
+	return cli.Services{
+		Artists: loadArtistsService(log, cliConfig, httpAPIClient, authService, marketResolver),
+		Albums:  loadAlbumsService(log, cliConfig, httpAPIClient, authService, marketResolver),
+		Tracks:  loadTracksService(log, cliConfig, httpAPIClient, authService, marketResolver),
+	}
 }
 
-func loadArtistsService(cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService) service.ArtistsService {
-	fmt.Println("Loading artists service...")
+func loadArtistsService(log io.Writer, cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService, marketResolver *utils.MarketResolver) service.ArtistsService {
+	_, _ = fmt.Fprintln(log, "Loading artists service...")
 	artistsSvc := service.NewSpotifyArtistsService(
 		cliConfig.BaseURL,
 		httpAPIClient,
 		authService,
+		marketResolver,
 	)
-	fmt.Printf("✔ Artist service loaded! :)\n\n")
+	_, _ = fmt.Fprintf(log, "✔ Artist service loaded! :)\n\n")
 	return artistsSvc
 }
 
-func loadAlbumsService(cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService) service.AlbumsService {
-	fmt.Println("Loading albums service...")
+func loadAlbumsService(log io.Writer, cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService, marketResolver *utils.MarketResolver) service.AlbumsService {
+	_, _ = fmt.Fprintln(log, "Loading albums service...")
 	albumsSvc := service.NewSpotifyAlbumsService(
 		cliConfig.BaseURL,
 		httpAPIClient,
 		authService,
+		marketResolver,
 	)
-	fmt.Printf("✔ Album service loaded! :)\n\n")
+	_, _ = fmt.Fprintf(log, "✔ Album service loaded! :)\n\n")
 	return albumsSvc
 }
 
-func loadTracksService(cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService) service.TracksService {
-	fmt.Println("Loading tracks service...")
+func loadTracksService(log io.Writer, cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService, marketResolver *utils.MarketResolver) service.TracksService {
+	_, _ = fmt.Fprintln(log, "Loading tracks service...")
 	tracksSvc := service.NewSpotifyTracksService(
 		cliConfig.BaseURL,
 		httpAPIClient,
 		authService,
+		marketResolver,
 	)
-	fmt.Printf("✔ Track service loaded! :)\n\n")
+	_, _ = fmt.Fprintf(log, "✔ Track service loaded! :)\n\n")
 	return tracksSvc
 }
+
+// newMarketResolver restricts the markets to the ones Spotify serves, fetched only once a command resolves a market.
+// When they cannot be fetched the markets are still resolved, just not checked against the served ones.
+func newMarketResolver(log io.Writer, cliConfig config.CliConfig, httpAPIClient client.HTTPApiClient, authService *service.SpotifyAuthService) *utils.MarketResolver {
+	marketsSvc := service.NewSpotifyMarketsService(
+		cliConfig.BaseURL,
+		httpAPIClient,
+		authService,
+	)
+	return utils.NewLazyMarketResolver(func(ctx context.Context) ([]model.AvailableMarket, error) {
+		_, _ = fmt.Fprintln(log, "Loading available markets...")
+		markets, err := marketsSvc.GetAvailableMarkets(ctx)
+		if err != nil {
+			_, _ = fmt.Fprintln(log, "✖ Available markets loading failed :(")
+			_, _ = fmt.Fprintf(log, "╰┈➤%s\n\n", err.Error())
+			return nil, err
+		}
+		_, _ = fmt.Fprintf(log, "✔ %d available markets loaded! :)\n\n", len(markets))
+		return markets, nil
+	})
+}
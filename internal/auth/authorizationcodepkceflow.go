@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"jezz-go-spotify-integration/internal/model"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	// for testing purposes
+	randRead = rand.Read
+)
+
+// OpenAuthorizeURLFn is called with the Spotify authorize URL the user must visit to grant access,
+// e.g. by printing it or opening it in the default browser.
+type OpenAuthorizeURLFn func(authorizeURL string) error
+
+// AuthorizationCodePKCEFlow authenticates a Spotify user with the Authorization Code with PKCE flow.
+// The authorization code is captured by a short-lived HTTP listener bound to the loopback redirect URL,
+// so the redirect URL must be registered in the Spotify app and point to 127.0.0.1, [::1] or localhost.
+type AuthorizationCodePKCEFlow struct {
+	accountURL       string
+	clientID         string
+	redirectURL      string
+	scopes           []string
+	httpClient       http.Client
+	openAuthorizeURL OpenAuthorizeURLFn
+}
+
+func NewAuthorizationCodePKCEFlow(
+	accountURL string,
+	clientID string,
+	redirectURL string,
+	scopes []string,
+	openAuthorizeURL OpenAuthorizeURLFn,
+) AuthorizationCodePKCEFlow {
+	return AuthorizationCodePKCEFlow{
+		accountURL:       accountURL,
+		clientID:         clientID,
+		redirectURL:      redirectURL,
+		scopes:           scopes,
+		httpClient:       http.Client{},
+		openAuthorizeURL: openAuthorizeURL,
+	}
+}
+
+// PrintAuthorizeURL returns an OpenAuthorizeURLFn that asks the user to open the authorize URL manually.
+func PrintAuthorizeURL(w io.Writer) OpenAuthorizeURLFn {
+	return func(authorizeURL string) error {
+		_, err := fmt.Fprintf(w, "Open the following URL in your browser to authorize the application:\n%s\n", authorizeURL)
+		return err
+	}
+}
+
+type authorizationCallback struct {
+	code string
+	err  error
+}
+
+func (c AuthorizationCodePKCEFlow) Authenticate(ctx context.Context) (*model.Authentication, error) {
+	redirectURL, err := c.parseRedirectURL()
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := generateCodeVerifier()
+	if err != nil {
+		return nil, fmt.Errorf("error generating code verifier - %w", err)
+	}
+	state, err := randomURLSafeString(stateLength)
+	if err != nil {
+		return nil, fmt.Errorf("error generating authorization state - %w", err)
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", redirectURL.Host)
+	if err != nil {
+		return nil, fmt.Errorf("error starting authorization callback listener - %w", err)
+	}
+	// when the configured port is 0 the redirect URL must carry the port actually assigned to the listener
+	redirectURL.Host = net.JoinHostPort(redirectURL.Hostname(), fmt.Sprint(listener.Addr().(*net.TCPAddr).Port))
+
+	callbacks := make(chan authorizationCallback, 1)
+	server := &http.Server{Handler: c.callbackHandler(redirectURL.Path, state, callbacks)}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer func() {
+		_ = server.Close()
+	}()
+
+	if err = c.openAuthorizeURL(c.authorizeURL(redirectURL.String(), codeChallenge(verifier), state)); err != nil {
+		return nil, fmt.Errorf("error opening authorize url - %w", err)
+	}
+
+	var callback authorizationCallback
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error waiting for user authorization - %w", ctx.Err())
+	case callback = <-callbacks:
+	}
+	if callback.err != nil {
+		return nil, callback.err
+	}
+
+	return c.exchangeCode(ctx, callback.code, redirectURL.String(), verifier)
+}
+
+func (c AuthorizationCodePKCEFlow) parseRedirectURL() (*url.URL, error) {
+	redirectURL, err := url.Parse(c.redirectURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing redirect url - %w", err)
+	}
+	if redirectURL.Scheme != "http" || redirectURL.Port() == "" {
+		return nil, fmt.Errorf("error parsing redirect url - must be an http url with an explicit port, got %q", c.redirectURL)
+	}
+	host := redirectURL.Hostname()
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("error parsing redirect url - host must be a loopback address, got %q", host)
+	}
+	if redirectURL.Path == "" {
+		redirectURL.Path = "/"
+	}
+	return redirectURL, nil
+}
+
+func (c AuthorizationCodePKCEFlow) authorizeURL(redirectURL string, challenge string, state string) string {
+	query := url.Values{}
+	query.Set("client_id", c.clientID)
+	query.Set("response_type", "code")
+	query.Set("redirect_uri", redirectURL)
+	query.Set("code_challenge_method", "S256")
+	query.Set("code_challenge", challenge)
+	query.Set("state", state)
+	if len(c.scopes) > 0 {
+		query.Set("scope", strings.Join(c.scopes, " "))
+	}
+	return c.accountURL + authorizePath + "?" + query.Encode()
+}
+
+// callbackHandler sends the result of the authorization to callbacks. Requests that can't be the redirect from
+// Spotify, such as a browser asking for a favicon or a probe without the expected state, are rejected without ending
+// the flow.
+func (c AuthorizationCodePKCEFlow) callbackHandler(path string, state string, callbacks chan<- authorizationCallback) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			http.NotFound(w, r)
+			return
+		}
+		query := r.URL.Query()
+		var callback authorizationCallback
+		// Spotify sends the state back on errors too, so a request without it never ends the flow
+		switch {
+		case query.Get("state") != state:
+			http.Error(w, "Unexpected authorization state.", http.StatusBadRequest)
+			return
+		case query.Get("error") != "":
+			callback.err = fmt.Errorf("error authorizing user - %s", query.Get("error"))
+		case query.Get("code") == "":
+			callback.err = errors.New("error authorizing user - no authorization code was provided")
+		default:
+			callback.code = query.Get("code")
+		}
+
+		if callback.err != nil {
+			http.Error(w, "Authorization failed, you can close this window.", http.StatusBadRequest)
+		} else {
+			_, _ = io.WriteString(w, "Authorization completed, you can close this window.")
+		}
+		select {
+		case callbacks <- callback:
+		default:
+		}
+	})
+	return mux
+}
+
+func (c AuthorizationCodePKCEFlow) exchangeCode(
+	ctx context.Context,
+	code string,
+	redirectURL string,
+	verifier string,
+) (*model.Authentication, error) {
+	formData := url.Values{}
+	formData.Set("grant_type", "authorization_code")
+	formData.Set("code", code)
+	formData.Set("redirect_uri", redirectURL)
+	formData.Set("client_id", c.clientID)
+	formData.Set("code_verifier", verifier)
+	return c.requestToken(ctx, formData)
+}
+
+// Refresh obtains a new access token with the refresh token. When Spotify doesn't rotate the refresh token
+// the one given is kept in the returned authentication.
+func (c AuthorizationCodePKCEFlow) Refresh(ctx context.Context, refreshToken model.RefreshToken) (*model.Authentication, error) {
+	formData := url.Values{}
+	formData.Set("grant_type", "refresh_token")
+	formData.Set("refresh_token", refreshToken.String())
+	formData.Set("client_id", c.clientID)
+
+	authResp, err := c.requestToken(ctx, formData)
+	if err != nil {
+		return nil, err
+	}
+	if authResp.RefreshToken == "" {
+		authResp.RefreshToken = refreshToken
+	}
+	return authResp, nil
+}
+
+func (c AuthorizationCodePKCEFlow) requestToken(ctx context.Context, formData url.Values) (*model.Authentication, error) {
+	req, err := httpNewRequestWithContext(ctx, "POST", c.accountURL+tokenPath, strings.NewReader(formData.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request - %w", formData.Get("grant_type"), err)
+	}
+	req.Header.Set("Content-Type", contentTypeForm)
+
+	resp, err := (&c.httpClient).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to authorization client - %w", err)
+	}
+
+	if err := validateTokenRespStatus(resp); err != nil {
+		return nil, err
+	}
+
+	authResp, err := parseTokenResponse(resp)
+	if err != nil {
+		return authResp, fmt.Errorf("error authenticating - %w", err)
+	}
+	return authResp, nil
+}
+
+func generateCodeVerifier() (string, error) {
+	return randomURLSafeString(codeVerifierLength)
+}
+
+func codeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// randomURLSafeString returns a random string with exactly length characters from the unreserved URL alphabet.
+func randomURLSafeString(length int) (string, error) {
+	buf := make([]byte, base64.RawURLEncoding.DecodedLen(length)+1)
+	if _, err := randRead(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)[:length], nil
+}
@@ -0,0 +1,323 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeAccountsServer struct {
+	*httptest.Server
+	clientID  string
+	code      string
+	challenge string
+}
+
+func newFakeAccountsServer(t *testing.T, clientID string, code string) *fakeAccountsServer {
+	t.Helper()
+	fake := &fakeAccountsServer{clientID: clientID, code: code}
+	fake.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tokenPath || r.Method != "POST" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("fake accounts server could not parse form: %v", err)
+		}
+		switch {
+		case r.PostForm.Get("grant_type") != "authorization_code",
+			r.PostForm.Get("client_id") != fake.clientID,
+			r.PostForm.Get("code") != fake.code,
+			r.PostForm.Get("redirect_uri") == "",
+			codeChallenge(r.PostForm.Get("code_verifier")) != fake.challenge:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"error":"invalid_grant","error_description":"Invalid authorization code"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"access_token":"user_access_token","token_type":"Bearer","expires_in":3600}`)
+	}))
+	t.Cleanup(fake.Close)
+	return fake
+}
+
+// callbackProbe is a request that reaches the callback listener before the redirect, such as a favicon request.
+type callbackProbe struct {
+	path       string
+	query      url.Values
+	wantStatus int
+}
+
+// browser simulates the user granting access: it checks the authorize url, sends the probes to the flow's callback
+// listener and then follows the redirect to it with the given query overrides.
+func (f *fakeAccountsServer) browser(t *testing.T, overrides url.Values, probes []callbackProbe) OpenAuthorizeURLFn {
+	return func(authorizeURL string) error {
+		parsed, err := url.Parse(authorizeURL)
+		if err != nil {
+			return err
+		}
+		query := parsed.Query()
+		if parsed.Path != authorizePath {
+			t.Errorf("authorize url path = %q, want %q", parsed.Path, authorizePath)
+		}
+		if query.Get("client_id") != f.clientID || query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" {
+			t.Errorf("authorize url has unexpected query %q", parsed.RawQuery)
+		}
+		if query.Get("scope") != "playlist-read-private user-library-read" {
+			t.Errorf("authorize url scope = %q", query.Get("scope"))
+		}
+		f.challenge = query.Get("code_challenge")
+
+		callback := url.Values{"code": {f.code}, "state": {query.Get("state")}}
+		for key, values := range overrides {
+			callback[key] = values
+		}
+		redirectURL, err := url.Parse(query.Get("redirect_uri"))
+		if err != nil {
+			return err
+		}
+		go func() {
+			for _, probe := range probes {
+				probeURL := *redirectURL
+				probeURL.Path = probe.path
+				probeURL.RawQuery = probe.query.Encode()
+				resp, err := http.Get(probeURL.String())
+				if err != nil {
+					t.Errorf("probe %s error = %v", probeURL.String(), err)
+					continue
+				}
+				_ = resp.Body.Close()
+				if resp.StatusCode != probe.wantStatus {
+					t.Errorf("probe %s status = %d, want %d", probeURL.String(), resp.StatusCode, probe.wantStatus)
+				}
+			}
+			resp, err := http.Get(redirectURL.String() + "?" + callback.Encode())
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+}
+
+func TestAuthorizationCodePKCEFlow_Authenticate(t *testing.T) {
+	scopes := []string{"playlist-read-private", "user-library-read"}
+	tests := []struct {
+		name        string
+		redirectURL string
+		overrides   url.Values
+		probes      []callbackProbe
+		openErr     error
+		timeout     time.Duration
+		want        *model.Authentication
+		wantErr     string
+	}{
+		{
+			name:        "should exchange the authorization code for an access token",
+			redirectURL: "http://127.0.0.1:0/callback",
+			want:        &model.Authentication{AccessToken: "user_access_token", TokenType: "Bearer", ExpiresIn: 3600},
+		},
+		{
+			name:        "should keep waiting when other requests reach the callback listener",
+			redirectURL: "http://127.0.0.1:0/callback",
+			probes: []callbackProbe{
+				{path: "/favicon.ico", wantStatus: http.StatusNotFound},
+				{path: "/callback", query: url.Values{"code": {"forged-code"}, "state": {"forged"}}, wantStatus: http.StatusBadRequest},
+				{path: "/callback", wantStatus: http.StatusBadRequest},
+				{path: "/callback", query: url.Values{"error": {"access_denied"}}, wantStatus: http.StatusBadRequest},
+				{path: "/callback", query: url.Values{"error": {"access_denied"}, "state": {"forged"}}, wantStatus: http.StatusBadRequest},
+			},
+			want: &model.Authentication{AccessToken: "user_access_token", TokenType: "Bearer", ExpiresIn: 3600},
+		},
+		{
+			name:        "should keep waiting on other paths when the redirect url has the root path",
+			redirectURL: "http://127.0.0.1:0",
+			probes:      []callbackProbe{{path: "/favicon.ico", wantStatus: http.StatusNotFound}},
+			want:        &model.Authentication{AccessToken: "user_access_token", TokenType: "Bearer", ExpiresIn: 3600},
+		},
+		{
+			name:        "should keep waiting until the context is done when state does not match",
+			redirectURL: "http://127.0.0.1:0/callback",
+			overrides:   url.Values{"state": {"forged"}},
+			timeout:     200 * time.Millisecond,
+			wantErr:     context.DeadlineExceeded.Error(),
+		},
+		{
+			name:        "should return error when user denies access",
+			redirectURL: "http://127.0.0.1:0/callback",
+			overrides:   url.Values{"error": {"access_denied"}, "code": {""}},
+			wantErr:     "access_denied",
+		},
+		{
+			name:        "should keep waiting until the context is done when an error comes without the state",
+			redirectURL: "http://127.0.0.1:0/callback",
+			overrides:   url.Values{"error": {"access_denied"}, "code": {""}, "state": {""}},
+			timeout:     200 * time.Millisecond,
+			wantErr:     context.DeadlineExceeded.Error(),
+		},
+		{
+			name:        "should return error when token endpoint rejects the code",
+			redirectURL: "http://127.0.0.1:0/callback",
+			overrides:   url.Values{"code": {"another-code"}},
+			wantErr:     "invalid_grant",
+		},
+		{
+			name:        "should return error when authorize url cannot be opened",
+			redirectURL: "http://127.0.0.1:0/callback",
+			openErr:     errors.New("mock browser error"),
+			wantErr:     "mock browser error",
+		},
+		{
+			name:        "should return error when redirect url is not a loopback address",
+			redirectURL: "http://example.com:8080/callback",
+			wantErr:     "loopback",
+		},
+		{
+			name:        "should return error when redirect url has no port",
+			redirectURL: "http://127.0.0.1/callback",
+			wantErr:     "explicit port",
+		},
+		{
+			name:        "should return error when context is done before the callback",
+			redirectURL: "http://127.0.0.1:0/callback",
+			timeout:     50 * time.Millisecond,
+			wantErr:     context.DeadlineExceeded.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accounts := newFakeAccountsServer(t, "client-id-mock", "auth-code-mock")
+			openAuthorizeURL := accounts.browser(t, tt.overrides, tt.probes)
+			switch {
+			case tt.openErr != nil:
+				openAuthorizeURL = func(_ string) error {
+					return tt.openErr
+				}
+			case tt.timeout > 0 && tt.overrides == nil:
+				openAuthorizeURL = func(_ string) error {
+					return nil
+				}
+			}
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			c := NewAuthorizationCodePKCEFlow(accounts.URL, "client-id-mock", tt.redirectURL, scopes, openAuthorizeURL)
+			got, err := c.Authenticate(ctx)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("Authenticate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// example from RFC 7636, appendix B
+	got := codeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	want := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	if got != want {
+		t.Errorf("codeChallenge() = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateCodeVerifier(t *testing.T) {
+	t.Run("should generate url safe verifiers with the expected length", func(t *testing.T) {
+		verifier, err := generateCodeVerifier()
+		if err != nil {
+			t.Fatalf("generateCodeVerifier() error = %v", err)
+		}
+		if len(verifier) != codeVerifierLength {
+			t.Errorf("generateCodeVerifier() length = %d, want %d", len(verifier), codeVerifierLength)
+		}
+		if strings.ContainsAny(verifier, "+/=") {
+			t.Errorf("generateCodeVerifier() = %q is not url safe", verifier)
+		}
+	})
+	t.Run("should return error when random source fails", func(t *testing.T) {
+		originalRandRead := randRead
+		defer func() {
+			randRead = originalRandRead
+		}()
+		randRead = func(_ []byte) (int, error) {
+			return 0, fmt.Errorf("mock random error")
+		}
+		if _, err := generateCodeVerifier(); err == nil {
+			t.Errorf("generateCodeVerifier() error = nil, want error")
+		}
+	})
+}
+
+func TestAuthorizationCodePKCEFlow_Refresh(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseCode int
+		responseBody string
+		want         *model.Authentication
+		wantErr      bool
+	}{
+		{
+			name:         "should keep the given refresh token when it is not rotated",
+			responseCode: http.StatusOK,
+			responseBody: `{"access_token":"refreshed_token","token_type":"Bearer","expires_in":3600,"scope":"user-library-read"}`,
+			want:         &model.Authentication{AccessToken: "refreshed_token", TokenType: "Bearer", ExpiresIn: 3600, RefreshToken: "refresh-token-mock", Scope: "user-library-read"},
+		},
+		{
+			name:         "should return the rotated refresh token",
+			responseCode: http.StatusOK,
+			responseBody: `{"access_token":"refreshed_token","token_type":"Bearer","expires_in":3600,"refresh_token":"rotated-refresh-token"}`,
+			want:         &model.Authentication{AccessToken: "refreshed_token", TokenType: "Bearer", ExpiresIn: 3600, RefreshToken: "rotated-refresh-token"},
+		},
+		{
+			name:         "should return error when refresh token is rejected",
+			responseCode: http.StatusBadRequest,
+			responseBody: `{"error":"invalid_grant","error_description":"Refresh token revoked"}`,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("fake accounts server could not parse form: %v", err)
+				}
+				if r.URL.Path != tokenPath || r.PostForm.Get("grant_type") != "refresh_token" ||
+					r.PostForm.Get("refresh_token") != "refresh-token-mock" || r.PostForm.Get("client_id") != "client-id-mock" {
+					t.Errorf("unexpected refresh request %s %q", r.URL.Path, r.PostForm.Encode())
+				}
+				w.WriteHeader(tt.responseCode)
+				_, _ = io.WriteString(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			c := NewAuthorizationCodePKCEFlow(server.URL, "client-id-mock", "http://127.0.0.1:0/callback", nil, nil)
+			got, err := c.Refresh(context.Background(), "refresh-token-mock")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Refresh() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && *got != *tt.want {
+				t.Errorf("Refresh() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
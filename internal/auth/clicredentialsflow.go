@@ -1,23 +1,17 @@
 package auth
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"jezz-go-spotify-integration/internal/commons"
 	"jezz-go-spotify-integration/internal/model"
 	"net/http"
 	"net/url"
 	"strings"
 )
 
-const (
-	cliCredentialsPath = "/api/token"
-)
-
 var (
 	// for testing purposes
-	httpNewRequest = http.NewRequest
+	httpNewRequestWithContext = http.NewRequestWithContext
 )
 
 type CliCredentialsFlow struct {
@@ -40,8 +34,8 @@ func NewCliCredentialsFlow(
 	}
 }
 
-func (c CliCredentialsFlow) Authenticate() (*model.Authentication, error) {
-	req, err := c.createRequest()
+func (c CliCredentialsFlow) Authenticate(ctx context.Context) (*model.Authentication, error) {
+	req, err := c.createRequest(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error creating client credentials request - %w", err)
 	}
@@ -62,52 +56,22 @@ func (c CliCredentialsFlow) Authenticate() (*model.Authentication, error) {
 	return authResp, nil
 }
 
-func (c CliCredentialsFlow) createRequest() (*http.Request, error) {
+func (c CliCredentialsFlow) createRequest(ctx context.Context) (*http.Request, error) {
 	formData := url.Values{}
 	formData.Set("grant_type", "client_credentials")
-	req, err := httpNewRequest("POST", c.accountURL+cliCredentialsPath, strings.NewReader(formData.Encode()))
+	req, err := httpNewRequestWithContext(ctx, "POST", c.accountURL+tokenPath, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Content-Type", contentTypeForm)
 	req.SetBasicAuth(c.clientID, c.clientSecret)
 	return req, err
 }
 
 func (c CliCredentialsFlow) validateRespStatus(resp *http.Response) error {
-	if resp.StatusCode != 200 {
-		appErr := commons.AppError{
-			Code:    resp.Status,
-			Message: "error authenticating",
-			Details: "no details were provided",
-		}
-		respBody, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return appErr
-		}
-		var authErr commons.AuthenticationError
-		if err = json.Unmarshal(respBody, &authErr); err == nil && authErr.Err != "" {
-			appErr.Message = authErr.Err
-			appErr.Details = authErr.ErrDescription
-		}
-		return appErr
-	}
-	return nil
+	return validateTokenRespStatus(resp)
 }
 
 func (c CliCredentialsFlow) parseResponse(resp *http.Response) (*model.Authentication, error) {
-	defer func(body io.ReadCloser) {
-		_ = body.Close()
-	}(resp.Body)
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	var authBody model.Authentication
-	if err = json.Unmarshal(respBody, &authBody); err != nil || authBody.AccessToken == "" {
-		return nil, commons.AppError{Code: resp.Status, Message: "error obtaining auth response"}
-	}
-
-	return &authBody, nil
+	return parseTokenResponse(resp)
 }
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -64,7 +65,7 @@ func TestCliCredentialsFlow_Authenticate(t *testing.T) {
 	}
 	tests := []struct {
 		name               string
-		mockHTTPNewRequest func(method, url string, body io.Reader) (*http.Request, error)
+		mockHTTPNewRequest func(ctx context.Context, method, url string, body io.Reader) (*http.Request, error)
 		mockRoundTripper   MockRoundTripper
 		want               want
 	}{
@@ -102,7 +103,7 @@ func TestCliCredentialsFlow_Authenticate(t *testing.T) {
 		},
 		{
 			name: "Error creating request",
-			mockHTTPNewRequest: func(_, _ string, _ io.Reader) (*http.Request, error) {
+			mockHTTPNewRequest: func(_ context.Context, _, _ string, _ io.Reader) (*http.Request, error) {
 				return nil, fmt.Errorf("mock request creation error")
 			},
 			mockRoundTripper: func(_ *http.Request) (*http.Response, error) {
@@ -168,11 +169,11 @@ func TestCliCredentialsFlow_Authenticate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.mockHTTPNewRequest != nil {
-				originalHTTPNewRequest := httpNewRequest
+				originalHTTPNewRequest := httpNewRequestWithContext
 				defer func() {
-					httpNewRequest = originalHTTPNewRequest
+					httpNewRequestWithContext = originalHTTPNewRequest
 				}()
-				httpNewRequest = tt.mockHTTPNewRequest
+				httpNewRequestWithContext = tt.mockHTTPNewRequest
 			}
 			c := CliCredentialsFlow{
 				accountURL:   accountURL,
@@ -180,7 +181,7 @@ func TestCliCredentialsFlow_Authenticate(t *testing.T) {
 				clientSecret: clientSecret,
 				httpClient:   *newMockClient(tt.mockRoundTripper), // Inject the mock client here!
 			}
-			authResp, err := c.Authenticate()
+			authResp, err := c.Authenticate(context.Background())
 
 			if tt.want.err {
 				if err == nil {
@@ -266,15 +267,15 @@ func TestCliCredentialsFlow_createRequest(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.config.deferHTTPNewRequestToError {
-				originalHTTPNewRequest := httpNewRequest
+				originalHTTPNewRequest := httpNewRequestWithContext
 				defer func() {
-					httpNewRequest = originalHTTPNewRequest
+					httpNewRequestWithContext = originalHTTPNewRequest
 				}()
-				httpNewRequest = func(_ string, _ string, _ io.Reader) (*http.Request, error) {
+				httpNewRequestWithContext = func(_ context.Context, _ string, _ string, _ io.Reader) (*http.Request, error) {
 					return nil, fmt.Errorf("mock error")
 				}
 			}
-			req, err := tt.cliCredentials.createRequest()
+			req, err := tt.cliCredentials.createRequest(context.Background())
 
 			if tt.want.err {
 				if err == nil {
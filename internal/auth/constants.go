@@ -0,0 +1,21 @@
+package auth
+
+const (
+	tokenPath     = "/api/token"
+	authorizePath = "/authorize"
+)
+
+const (
+	contentTypeForm = "application/x-www-form-urlencoded"
+)
+
+const (
+	// codeVerifierLength must be between 43 and 128 characters as required by RFC 7636
+	codeVerifierLength = 64
+	stateLength        = 16
+)
+
+const (
+	tokenStoreDirPermission  = 0o700
+	tokenStoreFilePermission = 0o600
+)
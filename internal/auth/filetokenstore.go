@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"jezz-go-spotify-integration/internal/model"
+	"os"
+	"path/filepath"
+)
+
+// FileTokenStore persists the user session as JSON in a file only readable by its owner.
+type FileTokenStore struct {
+	path string
+}
+
+func NewFileTokenStore(path string) FileTokenStore {
+	return FileTokenStore{path: path}
+}
+
+// Load returns nil without error when no session was saved yet.
+func (s FileTokenStore) Load() (*model.UserSession, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading user session file - %w", err)
+	}
+	var session model.UserSession
+	if err = json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("error parsing user session file %s - %w", s.path, err)
+	}
+	return &session, nil
+}
+
+func (s FileTokenStore) Save(session model.UserSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("error serializing user session - %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(s.path), tokenStoreDirPermission); err != nil {
+		return fmt.Errorf("error creating user session directory - %w", err)
+	}
+
+	// the session is written to a temporary file first, so a crash never leaves a truncated session behind
+	tmpFile, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating user session file - %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+	if err = tmpFile.Chmod(tokenStoreFilePermission); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("error restricting user session file permissions - %w", err)
+	}
+	if _, err = tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("error writing user session file - %w", err)
+	}
+	if err = tmpFile.Close(); err != nil {
+		return fmt.Errorf("error writing user session file - %w", err)
+	}
+	if err = os.Rename(tmpFile.Name(), s.path); err != nil {
+		return fmt.Errorf("error writing user session file - %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"jezz-go-spotify-integration/internal/model"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestFileTokenStore_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "session.json")
+	store := NewFileTokenStore(path)
+	session := model.UserSession{
+		AccessToken:  "access-token-mock",
+		TokenType:    "Bearer",
+		RefreshToken: "refresh-token-mock",
+		ExpiresAt:    time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+		Scopes:       []string{"playlist-read-private", "user-library-read"},
+	}
+
+	if err := store.Save(session); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Save() did not create session file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != tokenStoreFilePermission {
+		t.Errorf("Save() file permission = %o, want %o", perm, tokenStoreFilePermission)
+	}
+
+	got, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !reflect.DeepEqual(*got, session) {
+		t.Errorf("Load() = %+v, want %+v", *got, session)
+	}
+}
+
+func TestFileTokenStore_SaveRestrictsExistingFilePermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	if err := os.WriteFile(path, []byte(`{}`), 0o644); err != nil {
+		t.Fatalf("could not create session file: %v", err)
+	}
+
+	if err := NewFileTokenStore(path).Save(model.UserSession{AccessToken: "access-token-mock"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("could not stat session file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != tokenStoreFilePermission {
+		t.Errorf("Save() file permission = %o, want %o", perm, tokenStoreFilePermission)
+	}
+}
+
+func TestFileTokenStore_Load(t *testing.T) {
+	tests := []struct {
+		name    string
+		content *string
+		want    *model.UserSession
+		wantErr bool
+	}{
+		{
+			name:    "should return nil session when file does not exist",
+			content: nil,
+			want:    nil,
+		},
+		{
+			name:    "should return error when file content is malformed",
+			content: lo.ToPtr(`{"access_token": `),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "session.json")
+			if tt.content != nil {
+				if err := os.WriteFile(path, []byte(*tt.content), tokenStoreFilePermission); err != nil {
+					t.Fatalf("could not create session file: %v", err)
+				}
+			}
+
+			got, err := NewFileTokenStore(path).Load()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
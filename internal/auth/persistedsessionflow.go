@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+var (
+	// for testing purposes
+	timeNow = time.Now
+)
+
+// PersistedSessionFlow keeps the user session of an interactive flow in a TokenStore, so the user only has to
+// authorize again when there is no stored session, it was obtained for other scopes or its refresh token is no
+// longer accepted.
+type PersistedSessionFlow struct {
+	flow   RefreshableAuthenticationFlow
+	store  TokenStore
+	scopes []string
+}
+
+func NewPersistedSessionFlow(
+	flow RefreshableAuthenticationFlow,
+	store TokenStore,
+	scopes []string,
+) PersistedSessionFlow {
+	return PersistedSessionFlow{
+		flow:   flow,
+		store:  store,
+		scopes: scopes,
+	}
+}
+
+func (f PersistedSessionFlow) Authenticate(ctx context.Context) (*model.Authentication, error) {
+	session, err := f.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || !sameScopes(session.RequestedScopes, f.scopes) {
+		return f.authenticateAndSave(ctx)
+	}
+	if !session.IsExpired(timeNow()) {
+		authentication := session.Authentication(timeNow())
+		return &authentication, nil
+	}
+	if session.RefreshToken != "" {
+		return f.Refresh(ctx, session.RefreshToken)
+	}
+	return f.authenticateAndSave(ctx)
+}
+
+// Refresh renews the session with the refresh token and falls back to the interactive flow when it is rejected.
+// Any other error, such as a network error, is returned as is.
+func (f PersistedSessionFlow) Refresh(ctx context.Context, refreshToken model.RefreshToken) (*model.Authentication, error) {
+	obtainedAt := timeNow()
+	authentication, err := f.flow.Refresh(ctx, refreshToken)
+	if err != nil {
+		if ctx.Err() != nil || !isRejectedRefreshToken(err) {
+			return nil, err
+		}
+		return f.authenticateAndSave(ctx)
+	}
+	return f.save(authentication, obtainedAt)
+}
+
+func (f PersistedSessionFlow) authenticateAndSave(ctx context.Context) (*model.Authentication, error) {
+	obtainedAt := timeNow()
+	authentication, err := f.flow.Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return f.save(authentication, obtainedAt)
+}
+
+func (f PersistedSessionFlow) save(authentication *model.Authentication, obtainedAt time.Time) (*model.Authentication, error) {
+	session := model.NewUserSession(*authentication, obtainedAt)
+	session.RequestedScopes = f.scopes
+	if err := f.store.Save(session); err != nil {
+		return nil, fmt.Errorf("error persisting user session - %w", err)
+	}
+	return authentication, nil
+}
+
+// isRejectedRefreshToken tells whether the accounts service refused the refresh token itself, as opposed to failing
+// to answer.
+func isRejectedRefreshToken(err error) bool {
+	var appErr commons.AppError
+	if !errors.As(err, &appErr) {
+		return false
+	}
+	return appErr.Status == http.StatusUnauthorized ||
+		(appErr.Status == http.StatusBadRequest && appErr.Message == "invalid_grant")
+}
+
+func sameScopes(stored []string, configured []string) bool {
+	return lo.ElementsMatch(lo.Uniq(stored), lo.Uniq(configured))
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	mocks "jezz-go-spotify-integration/internal/mocks/auth"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPersistedSessionFlow_Authenticate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	originalTimeNow := timeNow
+	defer func() {
+		timeNow = originalTimeNow
+	}()
+	timeNow = func() time.Time {
+		return now
+	}
+
+	scopes := []string{"playlist-read-private"}
+	interactiveAuth := &model.Authentication{AccessToken: "interactive-token", TokenType: "Bearer", ExpiresIn: 3600, RefreshToken: "new-refresh-token", Scope: "playlist-read-private"}
+	refreshedAuth := &model.Authentication{AccessToken: "refreshed-token", TokenType: "Bearer", ExpiresIn: 3600, RefreshToken: "stored-refresh-token"}
+	storedSession := func(expiresAt time.Time, refreshToken model.RefreshToken) *model.UserSession {
+		return &model.UserSession{
+			AccessToken: "stored-token", TokenType: "Bearer", RefreshToken: refreshToken, ExpiresAt: expiresAt, RequestedScopes: scopes,
+		}
+	}
+	interactiveSession := &model.UserSession{
+		AccessToken: "interactive-token", TokenType: "Bearer", RefreshToken: "new-refresh-token",
+		ExpiresAt: now.Add(time.Hour), Scopes: []string{"playlist-read-private"}, RequestedScopes: scopes,
+	}
+
+	tests := []struct {
+		name        string
+		stored      *model.UserSession
+		loadErr     error
+		refreshErr  error
+		interactErr error
+		wantRefresh bool
+		wantAuth    bool
+		want        *model.Authentication
+		wantSaved   *model.UserSession
+		wantErr     bool
+	}{
+		{
+			name:      "should run interactive flow and save session when there is no stored session",
+			stored:    nil,
+			wantAuth:  true,
+			want:      interactiveAuth,
+			wantSaved: interactiveSession,
+		},
+		{
+			name:   "should reuse stored session while it is not expired",
+			stored: storedSession(now.Add(10*time.Minute), "stored-refresh-token"),
+			want:   &model.Authentication{AccessToken: "stored-token", TokenType: "Bearer", ExpiresIn: 600, RefreshToken: "stored-refresh-token"},
+		},
+		{
+			name:        "should refresh and save stored session when it is expired",
+			stored:      storedSession(now.Add(-time.Minute), "stored-refresh-token"),
+			wantRefresh: true,
+			want:        refreshedAuth,
+			wantSaved: &model.UserSession{
+				AccessToken: "refreshed-token", TokenType: "Bearer", RefreshToken: "stored-refresh-token", ExpiresAt: now.Add(time.Hour),
+				RequestedScopes: scopes,
+			},
+		},
+		{
+			name:        "should fall back to interactive flow when refresh token is rejected",
+			stored:      storedSession(now.Add(-time.Minute), "revoked-refresh-token"),
+			wantRefresh: true,
+			refreshErr:  commons.AppError{Status: 400, Message: "invalid_grant", Cause: commons.ErrUnauthorized},
+			wantAuth:    true,
+			want:        interactiveAuth,
+			wantSaved:   interactiveSession,
+		},
+		{
+			name:        "should fall back to interactive flow when refresh is unauthorized",
+			stored:      storedSession(now.Add(-time.Minute), "revoked-refresh-token"),
+			wantRefresh: true,
+			refreshErr:  commons.AppError{Status: 401, Message: "invalid_client", Cause: commons.ErrUnauthorized},
+			wantAuth:    true,
+			want:        interactiveAuth,
+			wantSaved:   interactiveSession,
+		},
+		{
+			name:        "should return error when refresh fails with a server error",
+			stored:      storedSession(now.Add(-time.Minute), "stored-refresh-token"),
+			wantRefresh: true,
+			refreshErr:  commons.AppError{Status: 503, Message: "error authenticating"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return error when refresh fails to connect",
+			stored:      storedSession(now.Add(-time.Minute), "stored-refresh-token"),
+			wantRefresh: true,
+			refreshErr:  errors.New("error connecting to authorization client - connection reset by peer"),
+			wantErr:     true,
+		},
+		{
+			name:        "should return error when refresh is rejected for another reason than the grant",
+			stored:      storedSession(now.Add(-time.Minute), "stored-refresh-token"),
+			wantRefresh: true,
+			refreshErr:  commons.AppError{Status: 400, Message: "invalid_client", Cause: commons.ErrUnauthorized},
+			wantErr:     true,
+		},
+		{
+			name: "should run interactive flow when configured scopes changed",
+			stored: &model.UserSession{
+				AccessToken: "stored-token", TokenType: "Bearer", RefreshToken: "stored-refresh-token",
+				ExpiresAt: now.Add(10 * time.Minute), RequestedScopes: []string{"user-library-read"},
+			},
+			wantAuth:  true,
+			want:      interactiveAuth,
+			wantSaved: interactiveSession,
+		},
+		{
+			name:      "should run interactive flow when expired session has no refresh token",
+			stored:    storedSession(now.Add(-time.Minute), ""),
+			wantAuth:  true,
+			want:      interactiveAuth,
+			wantSaved: interactiveSession,
+		},
+		{
+			name:    "should return error when session cannot be loaded",
+			loadErr: errors.New("mock load error"),
+			wantErr: true,
+		},
+		{
+			name:        "should return error when interactive flow fails",
+			wantAuth:    true,
+			interactErr: errors.New("mock auth error"),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flow := mocks.NewRefreshableAuthenticationFlow(t)
+			store := mocks.NewTokenStore(t)
+			store.On("Load").Return(tt.stored, tt.loadErr).Once()
+			if tt.wantRefresh {
+				if tt.refreshErr != nil {
+					flow.On("Refresh", mock.Anything, tt.stored.RefreshToken).Return(nil, tt.refreshErr).Once()
+				} else {
+					flow.On("Refresh", mock.Anything, tt.stored.RefreshToken).Return(refreshedAuth, nil).Once()
+				}
+			}
+			if tt.wantAuth {
+				if tt.interactErr != nil {
+					flow.On("Authenticate", mock.Anything).Return(nil, tt.interactErr).Once()
+				} else {
+					flow.On("Authenticate", mock.Anything).Return(interactiveAuth, nil).Once()
+				}
+			}
+			if tt.wantSaved != nil {
+				store.On("Save", *tt.wantSaved).Return(nil).Once()
+			}
+
+			f := NewPersistedSessionFlow(flow, store, scopes)
+			got, err := f.Authenticate(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if *got != *tt.want {
+				t.Errorf("Authenticate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPersistedSessionFlow_Refresh_SaveError(t *testing.T) {
+	flow := mocks.NewRefreshableAuthenticationFlow(t)
+	store := mocks.NewTokenStore(t)
+	flow.On("Refresh", mock.Anything, model.RefreshToken("refresh-token")).Return(&model.Authentication{AccessToken: "refreshed-token"}, nil).Once()
+	store.On("Save", mock.Anything).Return(errors.New("mock save error")).Once()
+
+	if _, err := NewPersistedSessionFlow(flow, store, nil).Refresh(context.Background(), "refresh-token"); err == nil {
+		t.Errorf("Refresh() error = nil, want error")
+	}
+}
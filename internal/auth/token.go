@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"encoding/json"
+	"io"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+)
+
+func validateTokenRespStatus(resp *http.Response) error {
+	if resp.StatusCode != 200 {
+		appErr := commons.AppError{
+			Code:    resp.Status,
+			Message: "error authenticating",
+			Details: "no details were provided",
+			Status:  resp.StatusCode,
+		}
+		if resp.Request != nil {
+			appErr.Endpoint = resp.Request.Method + " " + resp.Request.URL.String()
+		}
+		// the accounts service answers rejected credentials or grants with a 400, which is still an auth failure
+		if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+			appErr.Cause = commons.ErrUnauthorized
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return appErr
+		}
+		var authErr commons.AuthenticationError
+		if err = json.Unmarshal(respBody, &authErr); err == nil && authErr.Err != "" {
+			appErr.Message = authErr.Err
+			appErr.Details = authErr.ErrDescription
+		}
+		return appErr
+	}
+	return nil
+}
+
+func parseTokenResponse(resp *http.Response) (*model.Authentication, error) {
+	defer func(body io.ReadCloser) {
+		_ = body.Close()
+	}(resp.Body)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var authBody model.Authentication
+	if err = json.Unmarshal(respBody, &authBody); err != nil || authBody.AccessToken == "" {
+		return nil, commons.AppError{Code: resp.Status, Message: "error obtaining auth response"}
+	}
+
+	return &authBody, nil
+}
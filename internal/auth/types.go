@@ -1,7 +1,20 @@
 package auth
 
-import "jezz-go-spotify-integration/internal/model"
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/model"
+)
 
 type AuthenticationFlow interface {
-	Authenticate() (*model.Authentication, error)
+	Authenticate(ctx context.Context) (*model.Authentication, error)
+}
+
+type RefreshableAuthenticationFlow interface {
+	AuthenticationFlow
+	Refresh(ctx context.Context, refreshToken model.RefreshToken) (*model.Authentication, error)
+}
+
+type TokenStore interface {
+	Load() (*model.UserSession, error)
+	Save(session model.UserSession) error
 }
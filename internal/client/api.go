@@ -1,80 +1,205 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"jezz-go-spotify-integration/internal/commons"
 	"jezz-go-spotify-integration/internal/model"
 	"net/http"
+	neturl "net/url"
 	"reflect"
-	"strings"
-
-	"github.com/samber/lo"
+	"time"
 )
 
 var (
-	httpNewRequest = http.NewRequest
-	ioReadAll      = io.ReadAll
-	jsonUnmarshal  = json.Unmarshal
-	reflectValueOf = reflect.ValueOf
+	httpNewRequestWithContext = http.NewRequestWithContext
+	ioReadAll                 = io.ReadAll
+	jsonMarshal               = json.Marshal
+	jsonUnmarshal             = json.Unmarshal
+	jsonUnmarshalStrict       = model.UnmarshalStrict
+	reflectValueOf            = reflect.ValueOf
+	newRequestID              = func() string {
+		b := make([]byte, 16)
+		_, _ = rand.Read(b)
+		return hex.EncodeToString(b)
+	}
 )
 
+// RequestIDHeader is sent with every request so failures can be correlated with it. When the API answers with
+// its own request ID in the same header, that one is reported instead.
+const RequestIDHeader = "X-Request-Id"
+
+// apiErrorEnvelope is the body of a non successful Spotify API answer
+type apiErrorEnvelope struct {
+	Error *commons.ResourceError `json:"error"`
+}
+
 type CustomHTTPApiClient struct {
-	httpClient *http.Client
+	httpClient     *http.Client
+	retryPolicy    RetryPolicy
+	rateLimiter    *RateLimiter
+	strictDecoding bool
 }
 
-func NewCustomHTTPApiClient() CustomHTTPApiClient {
+func NewCustomHTTPApiClient(retryPolicy RetryPolicy) CustomHTTPApiClient {
 	return CustomHTTPApiClient{
-		httpClient: &http.Client{},
+		httpClient:  &http.Client{},
+		retryPolicy: retryPolicy,
 	}
 }
 
+// WithStrictDecoding makes responses with fields the model doesn't map fail to parse instead of being partially
+// decoded, so changes in the API payloads are noticed.
+func (c CustomHTTPApiClient) WithStrictDecoding(strict bool) CustomHTTPApiClient {
+	c.strictDecoding = strict
+	return c
+}
+
+// WithTimeout limits how long each attempt of a request may take, including reading the response. Zero means no
+// limit.
+func (c CustomHTTPApiClient) WithTimeout(timeout time.Duration) CustomHTTPApiClient {
+	c.httpClient = &http.Client{Timeout: timeout}
+	return c
+}
+
+// WithRateLimiter throttles every attempt of the requests with limiter, retries included, and adapts its rate to the
+// 429 responses as soon as they are received.
+func (c CustomHTTPApiClient) WithRateLimiter(limiter *RateLimiter) CustomHTTPApiClient {
+	c.rateLimiter = limiter
+	return c
+}
+
 func (c CustomHTTPApiClient) DoRequest(
+	ctx context.Context,
 	method model.HTTPMethod,
 	url string,
 	queryParams *model.QueryParams,
 	contentType string,
 	accessToken *model.AccessToken,
+	requestBody any,
 	responseTypedOutput any,
 ) error {
-	req, cErr := c.createRequest(method, url, queryParams, contentType, accessToken)
-	if cErr != nil {
-		return fmt.Errorf("error creating request - %s", cErr)
-	}
-
-	resp, reqErr := c.httpClient.Do(req)
-	if reqErr != nil {
-		return fmt.Errorf("error executing request - %w", reqErr)
+	var body []byte
+	if requestBody != nil {
+		var mErr error
+		if body, mErr = jsonMarshal(requestBody); mErr != nil {
+			return fmt.Errorf("error serializing request body - %w", mErr)
+		}
 	}
 
-	if vErr := c.validateResponseStatus(resp); vErr != nil {
-		return vErr
+	call := apiCall{endpoint: method.String() + " " + url, requestID: newRequestID()}
+	resp, err := c.executeWithRetry(ctx, call, method, url, queryParams, contentType, accessToken, body)
+	if err != nil {
+		return err
 	}
 
-	if pErr := c.parseResponse(resp, responseTypedOutput); pErr != nil {
+	if pErr := c.parseResponse(call, resp, responseTypedOutput); pErr != nil {
 		return fmt.Errorf("error parsing response - %w", pErr)
 	}
 	return nil
 }
 
+// apiCall identifies a request in the errors it fails with
+type apiCall struct {
+	endpoint  string
+	requestID string
+}
+
+func (a apiCall) requestIDOf(resp *http.Response) string {
+	if id := resp.Header.Get(RequestIDHeader); id != "" {
+		return id
+	}
+	return a.requestID
+}
+
+func (c CustomHTTPApiClient) executeWithRetry(
+	ctx context.Context,
+	call apiCall,
+	method model.HTTPMethod,
+	url string,
+	queryParams *model.QueryParams,
+	contentType string,
+	accessToken *model.AccessToken,
+	body []byte,
+) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		req, cErr := c.createRequest(ctx, method, url, queryParams, contentType, accessToken, body)
+		if cErr != nil {
+			return nil, fmt.Errorf("error creating request - %w", cErr)
+		}
+		req.Header.Set(RequestIDHeader, call.requestID)
+
+		if c.rateLimiter != nil {
+			if lErr := c.rateLimiter.acquire(ctx); lErr != nil {
+				return nil, lErr
+			}
+		}
+		resp, reqErr := c.httpClient.Do(req)
+		if reqErr != nil {
+			err := fmt.Errorf("error executing request %s - %w", call.endpoint, reqErr)
+			if ctx.Err() != nil || !c.retryPolicy.shouldRetryTransportError(isIdempotent(ctx, method), attempt) {
+				return nil, err
+			}
+			if sErr := sleepWithContext(ctx, c.retryPolicy.backoff(attempt)); sErr != nil {
+				return nil, fmt.Errorf("error waiting to retry request - %w", sErr)
+			}
+			continue
+		}
+
+		vErr := c.validateResponseStatus(call, resp)
+		if vErr == nil {
+			c.observeRateLimit(nil)
+			return resp, nil
+		}
+		c.observeRateLimit(vErr)
+		if !c.retryPolicy.shouldRetry(isIdempotent(ctx, method), attempt, resp.StatusCode) {
+			return nil, vErr
+		}
+
+		delay, ok := c.retryPolicy.delay(attempt, resp.Header.Get("Retry-After"))
+		if !ok {
+			return nil, vErr
+		}
+		if sErr := sleepWithContext(ctx, delay); sErr != nil {
+			return nil, fmt.Errorf("error waiting to retry request - %w", sErr)
+		}
+	}
+}
+
+func (c CustomHTTPApiClient) observeRateLimit(err error) {
+	if c.rateLimiter != nil {
+		c.rateLimiter.observe(err)
+	}
+}
+
 func (c CustomHTTPApiClient) createRequest(
+	ctx context.Context,
 	method model.HTTPMethod,
 	url string,
 	queryParams *model.QueryParams,
 	contentType string,
 	accessToken *model.AccessToken,
+	body []byte,
 ) (*http.Request, error) {
 	queryParamsMap := c.parseQueryParams(queryParams)
 	if len(queryParamsMap) > 0 {
-		url += "?" + strings.Join(
-			lo.MapToSlice(queryParamsMap, func(key string, value string) string {
-				return key + "=" + value
-			}),
-			"&",
-		)
-	}
-	req, err := httpNewRequest(method.String(), url, nil)
+		query := neturl.Values{}
+		for key, value := range queryParamsMap {
+			query.Set(key, value)
+		}
+		url += "?" + query.Encode()
+	}
+	// the body is read again on every attempt, so each request gets its own reader
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := httpNewRequestWithContext(ctx, method.String(), url, bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -86,24 +211,42 @@ func (c CustomHTTPApiClient) createRequest(
 	return req, err
 }
 
-func (c CustomHTTPApiClient) validateResponseStatus(resp *http.Response) *commons.ResourceError {
+func (c CustomHTTPApiClient) validateResponseStatus(call apiCall, resp *http.Response) *commons.ResourceError {
 	if resp.StatusCode >= 300 {
+		defer func(body io.ReadCloser) {
+			_ = body.Close()
+		}(resp.Body)
+
 		apiErr := commons.ResourceError{
 			Status:  resp.StatusCode,
 			Message: "API http status is not success",
 		}
-		respBody, err := ioReadAll(resp.Body)
-		if err != nil {
-			return &apiErr
+		if respBody, err := ioReadAll(resp.Body); err == nil {
+			apiErr = parseAPIError(respBody, apiErr)
 		}
-
-		_ = jsonUnmarshal(respBody, &apiErr)
+		apiErr.Status = resp.StatusCode
+		apiErr.Endpoint = call.endpoint
+		apiErr.RequestID = call.requestIDOf(resp)
 		return &apiErr
 	}
 	return nil
 }
 
-func (c CustomHTTPApiClient) parseResponse(resp *http.Response, output any) error {
+// parseAPIError reads the error from both the {"error": {...}} envelope Spotify answers with and a bare error object,
+// keeping fallback when the body is neither.
+func parseAPIError(respBody []byte, fallback commons.ResourceError) commons.ResourceError {
+	envelope := apiErrorEnvelope{Error: &commons.ResourceError{}}
+	if err := jsonUnmarshal(respBody, &envelope); err == nil && envelope.Error != nil && envelope.Error.Message != "" {
+		return *envelope.Error
+	}
+	apiErr := fallback
+	if err := jsonUnmarshal(respBody, &apiErr); err != nil {
+		return fallback
+	}
+	return apiErr
+}
+
+func (c CustomHTTPApiClient) parseResponse(call apiCall, resp *http.Response, output any) error {
 	defer func(body io.ReadCloser) {
 		_ = body.Close()
 	}(resp.Body)
@@ -112,15 +255,28 @@ func (c CustomHTTPApiClient) parseResponse(resp *http.Response, output any) erro
 	if err != nil {
 		return err
 	}
+	if output == nil || len(bytes.TrimSpace(respBody)) == 0 {
+		return nil
+	}
 
-	if err = jsonUnmarshal(respBody, output); err != nil {
-		var apiErr commons.ResourceError
-		if err2 := jsonUnmarshal(respBody, &apiErr); err2 == nil && apiErr.Message != "" {
+	unmarshal := jsonUnmarshal
+	if c.strictDecoding {
+		unmarshal = jsonUnmarshalStrict
+	}
+	if err = unmarshal(respBody, output); err != nil {
+		if apiErr := parseAPIError(respBody, commons.ResourceError{}); apiErr.Message != "" {
+			apiErr.Endpoint = call.endpoint
+			apiErr.RequestID = call.requestIDOf(resp)
 			return apiErr
 		}
 		return commons.AppError{
-			Code:    resp.Status,
-			Message: "error parsing http response, no details were provided",
+			Code:      resp.Status,
+			Message:   "error parsing http response, no details were provided",
+			Details:   err.Error(),
+			Status:    resp.StatusCode,
+			Endpoint:  call.endpoint,
+			RequestID: call.requestIDOf(resp),
+			Cause:     commons.ErrUnexpectedResponse,
 		}
 	}
 	return nil
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestCustomHTTPApiClient_createRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams *model.QueryParams
+		wantQuery   string
+	}{
+		{
+			name:      "should not add query string without params",
+			wantQuery: "",
+		},
+		{
+			name: "should skip nil params and sort the others",
+			queryParams: &model.QueryParams{
+				"market": (*model.AvailableMarket)(nil),
+				"limit":  lo.ToPtr(model.Limit(10)),
+				"ids":    model.AlbumsIDs{"id-1", "id-2"},
+			},
+			wantQuery: "ids=id-1%2Cid-2&limit=10",
+		},
+		{
+			name: "should escape reserved characters",
+			queryParams: &model.QueryParams{
+				"q": model.NewSearchQuery("blue & green").Artist("Miles Davis"),
+			},
+			wantQuery: "q=blue+%26+green+artist%3A%22Miles+Davis%22",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCustomHTTPApiClient(NoRetryPolicy())
+			accessToken := model.AccessToken("token")
+			req, err := c.createRequest(context.Background(), model.HTTPGet, "https://api.spotify.com/v1/search", tt.queryParams, ContentTypeJSON, &accessToken, nil)
+			if err != nil {
+				t.Fatalf("createRequest() error = %v", err)
+			}
+			if req.URL.RawQuery != tt.wantQuery {
+				t.Errorf("createRequest() query = %q, want %q", req.URL.RawQuery, tt.wantQuery)
+			}
+			if req.Header.Get("Authorization") != "Bearer token" {
+				t.Errorf("createRequest() Authorization header = %q", req.Header.Get("Authorization"))
+			}
+		})
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_Body(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       model.HTTPMethod
+		requestBody  any
+		responseBody string
+		statuses     []int
+		wantBody     string
+		wantCalls    int32
+		wantOutput   string
+	}{
+		{
+			name:         "should send the request body as json and parse the response",
+			method:       model.HTTPPost,
+			requestBody:  map[string][]string{"uris": {"spotify:track:1"}},
+			responseBody: `{"value":"snapshot-1"}`,
+			statuses:     []int{http.StatusCreated},
+			wantBody:     `{"uris":["spotify:track:1"]}`,
+			wantCalls:    1,
+			wantOutput:   "snapshot-1",
+		},
+		{
+			name:        "should send the same body again when retrying",
+			method:      model.HTTPPut,
+			requestBody: map[string]string{"name": "new name"},
+			statuses:    []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantBody:    `{"name":"new name"}`,
+			wantCalls:   2,
+		},
+		{
+			name:      "should send no body and accept empty responses",
+			method:    model.HTTPDelete,
+			statuses:  []int{http.StatusOK},
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSleep(t)
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := calls.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != tt.method.String() || string(body) != tt.wantBody {
+					t.Errorf("request %d = %s %q, want %s %q", call, r.Method, body, tt.method, tt.wantBody)
+				}
+				w.WriteHeader(tt.statuses[call-1])
+				_, _ = io.WriteString(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			c := NewCustomHTTPApiClient(DefaultRetryPolicy())
+			output := &dummyOutput{}
+			err := c.DoRequest(context.Background(), tt.method, server.URL, nil, ContentTypeJSON, nil, tt.requestBody, output)
+
+			if err != nil {
+				t.Fatalf("DoRequest() error = %v", err)
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("DoRequest() calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+			if output.Value != tt.wantOutput {
+				t.Errorf("DoRequest() output = %q, want %q", output.Value, tt.wantOutput)
+			}
+		})
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_BodySerializationError(t *testing.T) {
+	c := NewCustomHTTPApiClient(NoRetryPolicy())
+	err := c.DoRequest(context.Background(), model.HTTPPost, "http://dummy.url", nil, ContentTypeJSON, nil, make(chan int), nil)
+	if err == nil {
+		t.Errorf("DoRequest() error = nil, want serialization error")
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_StrictDecoding(t *testing.T) {
+	tests := []struct {
+		name           string
+		strictDecoding bool
+		wantErr        bool
+	}{
+		{name: "should ignore unknown response fields by default"},
+		{name: "should return error on unknown response fields when decoding strictly", strictDecoding: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = io.WriteString(w, `{"value":"ok","added_later":true}`)
+			}))
+			defer server.Close()
+
+			c := NewCustomHTTPApiClient(NoRetryPolicy()).WithStrictDecoding(tt.strictDecoding)
+			output := &dummyOutput{}
+			err := c.DoRequest(context.Background(), model.HTTPGet, server.URL, nil, ContentTypeJSON, nil, nil, output)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DoRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var appErr commons.AppError
+			if tt.wantErr && (!errors.As(err, &appErr) || !strings.Contains(appErr.Details, "added_later")) {
+				t.Errorf("DoRequest() error = %v, want the unknown field reported", err)
+			}
+		})
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		_, _ = io.WriteString(w, `{"value":"ok"}`)
+	}))
+	defer server.Close()
+
+	c := NewCustomHTTPApiClient(NoRetryPolicy()).WithTimeout(20 * time.Millisecond)
+	err := c.DoRequest(context.Background(), model.HTTPGet, server.URL, nil, ContentTypeJSON, nil, nil, &dummyOutput{})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DoRequest() error = %v, want the request timed out", err)
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_ErrorDetails(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		responseHeaders map[string]string
+		wantMessage     string
+		wantRequestID   string
+	}{
+		{
+			name:          "should read the spotify error envelope and report the sent request id",
+			body:          `{"error":{"status":404,"message":"Non existing id"}}`,
+			wantMessage:   "Non existing id",
+			wantRequestID: "request-1",
+		},
+		{
+			name:            "should prefer the request id the api answers with",
+			body:            `{"status":404,"message":"Resource not found"}`,
+			responseHeaders: map[string]string{RequestIDHeader: "api-request-1"},
+			wantMessage:     "Resource not found",
+			wantRequestID:   "api-request-1",
+		},
+		{
+			name:          "should keep a default message when the body is not an error",
+			body:          `not json`,
+			wantMessage:   "API http status is not success",
+			wantRequestID: "request-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalNewRequestID := newRequestID
+			defer func() {
+				newRequestID = originalNewRequestID
+			}()
+			newRequestID = func() string {
+				return "request-1"
+			}
+			var sentRequestID string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sentRequestID = r.Header.Get(RequestIDHeader)
+				for key, value := range tt.responseHeaders {
+					w.Header().Set(key, value)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+
+			c := NewCustomHTTPApiClient(NoRetryPolicy())
+			err := c.DoRequest(context.Background(), model.HTTPGet, server.URL+"/v1/albums/1", nil, ContentTypeJSON, nil, nil, &dummyOutput{})
+
+			if !errors.Is(err, commons.ErrNotFound) || commons.IsRetryable(err) {
+				t.Fatalf("DoRequest() error = %v, want non retryable %v", err, commons.ErrNotFound)
+			}
+			if sentRequestID != "request-1" {
+				t.Errorf("DoRequest() sent request id = %q, want %q", sentRequestID, "request-1")
+			}
+			var resErr *commons.ResourceError
+			if !errors.As(err, &resErr) {
+				t.Fatalf("DoRequest() error of unexpected type %T, want *commons.ResourceError", err)
+			}
+			want := commons.ResourceError{
+				Status:    http.StatusNotFound,
+				Message:   tt.wantMessage,
+				Endpoint:  "GET " + server.URL + "/v1/albums/1",
+				RequestID: tt.wantRequestID,
+			}
+			if *resErr != want {
+				t.Errorf("DoRequest() error = %+v, want %+v", *resErr, want)
+			}
+		})
+	}
+}
@@ -1,5 +1,21 @@
 package client
 
+import "time"
+
 const (
 	ContentTypeJSON = "application/json"
 )
+
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 500 * time.Millisecond
+	DefaultRetryMaxDelay    = 10 * time.Second
+)
+
+const (
+	DefaultRateLimitRequestsPerSecond    = 10
+	DefaultRateLimitBurst                = 10
+	DefaultRateLimitMinRequestsPerSecond = 1
+
+	rateLimitRecoveryFactor = 0.1
+)
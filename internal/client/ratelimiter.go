@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"math"
+	"sync"
+	"time"
+)
+
+var ErrRateLimitExceeded = fmt.Errorf("client side rate limit exceeded - %w", commons.ErrRateLimited)
+
+type RateLimitMode int
+
+const (
+	// RateLimitModeWait blocks the caller until a token is available or its context is done.
+	RateLimitModeWait RateLimitMode = iota
+	// RateLimitModeFail returns ErrRateLimitExceeded right away when no token is available.
+	RateLimitModeFail
+)
+
+type RateLimitConfig struct {
+	RequestsPerSecond    float64
+	Burst                int
+	Mode                 RateLimitMode
+	MinRequestsPerSecond float64
+}
+
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		RequestsPerSecond:    DefaultRateLimitRequestsPerSecond,
+		Burst:                DefaultRateLimitBurst,
+		Mode:                 RateLimitModeWait,
+		MinRequestsPerSecond: DefaultRateLimitMinRequestsPerSecond,
+	}
+}
+
+// RateLimiter is a token bucket shared by the requests of a client. Every 429 response halves the allowed rate (down
+// to MinRequestsPerSecond) and every successful response slowly restores it back to RequestsPerSecond, so callers
+// sharing it adapt to the API limits together.
+type RateLimiter struct {
+	mode   RateLimitMode
+	bucket *tokenBucket
+}
+
+func NewRateLimiter(rateLimitConfig RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		mode:   rateLimitConfig.Mode,
+		bucket: newTokenBucket(rateLimitConfig),
+	}
+}
+
+// RequestsPerSecond returns the rate currently allowed by the limiter.
+func (l *RateLimiter) RequestsPerSecond() float64 {
+	return l.bucket.currentRate()
+}
+
+func (l *RateLimiter) acquire(ctx context.Context) error {
+	if l.mode == RateLimitModeFail {
+		if !l.bucket.tryTake() {
+			return ErrRateLimitExceeded
+		}
+		return nil
+	}
+
+	delay := l.bucket.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleepWithContext(ctx, delay); err != nil {
+		l.bucket.cancelReservation()
+		return fmt.Errorf("error waiting for rate limiter - %w", err)
+	}
+	return nil
+}
+
+// observe adapts the rate to the outcome of a request.
+func (l *RateLimiter) observe(err error) {
+	switch {
+	case errors.Is(err, commons.ErrRateLimited):
+		l.bucket.decreaseRate()
+	case err == nil:
+		l.bucket.increaseRate()
+	}
+}
+
+// RateLimitedHTTPApiClient throttles the requests sent through the wrapped HTTPApiClient with a RateLimiter. It only
+// sees whole requests, so a CustomHTTPApiClient, which retries, should rather be given the limiter with
+// WithRateLimiter to have each of its attempts throttled.
+type RateLimitedHTTPApiClient struct {
+	httpClient HTTPApiClient
+	limiter    *RateLimiter
+}
+
+func NewRateLimitedHTTPApiClient(
+	httpClient HTTPApiClient,
+	rateLimitConfig RateLimitConfig,
+) *RateLimitedHTTPApiClient {
+	return &RateLimitedHTTPApiClient{
+		httpClient: httpClient,
+		limiter:    NewRateLimiter(rateLimitConfig),
+	}
+}
+
+func (c *RateLimitedHTTPApiClient) DoRequest(
+	ctx context.Context,
+	method model.HTTPMethod,
+	url string,
+	queryParams *model.QueryParams,
+	contentType string,
+	accessToken *model.AccessToken,
+	requestBody any,
+	responseTypedOutput any,
+) error {
+	if err := c.limiter.acquire(ctx); err != nil {
+		return err
+	}
+
+	err := c.httpClient.DoRequest(ctx, method, url, queryParams, contentType, accessToken, requestBody, responseTypedOutput)
+	c.limiter.observe(err)
+	return err
+}
+
+// RequestsPerSecond returns the rate currently allowed by the limiter.
+func (c *RateLimitedHTTPApiClient) RequestsPerSecond() float64 {
+	return c.limiter.RequestsPerSecond()
+}
+
+type tokenBucket struct {
+	mu      sync.Mutex
+	rate    float64
+	maxRate float64
+	minRate float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+}
+
+func newTokenBucket(rateLimitConfig RateLimitConfig) *tokenBucket {
+	burst := math.Max(float64(rateLimitConfig.Burst), 1)
+	minRate := rateLimitConfig.MinRequestsPerSecond
+	if minRate <= 0 || minRate > rateLimitConfig.RequestsPerSecond {
+		minRate = rateLimitConfig.RequestsPerSecond
+	}
+	return &tokenBucket{
+		rate:    rateLimitConfig.RequestsPerSecond,
+		maxRate: rateLimitConfig.RequestsPerSecond,
+		minRate: minRate,
+		burst:   burst,
+		tokens:  burst,
+		last:    timeNow(),
+	}
+}
+
+func (b *tokenBucket) refill() {
+	now := timeNow()
+	elapsed := now.Sub(b.last).Seconds()
+	b.last = now
+	if elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+	}
+}
+
+func (b *tokenBucket) tryTake() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// reserve takes a token, possibly borrowing from the future, and returns how long the caller must wait for it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.tokens--
+	if b.tokens >= 0 || b.rate <= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) cancelReservation() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+func (b *tokenBucket) decreaseRate() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.rate = math.Max(b.minRate, b.rate/2)
+	b.tokens = math.Min(b.tokens, 0)
+}
+
+func (b *tokenBucket) increaseRate() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rate >= b.maxRate {
+		return
+	}
+	b.refill()
+	b.rate = math.Min(b.maxRate, b.rate+b.maxRate*rateLimitRecoveryFactor)
+}
+
+func (b *tokenBucket) currentRate() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.rate
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func mockClock(t *testing.T) *time.Time {
+	t.Helper()
+	originalTimeNow := timeNow
+	t.Cleanup(func() {
+		timeNow = originalTimeNow
+	})
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return now
+	}
+	return &now
+}
+
+func doRateLimitedRequest(c *RateLimitedHTTPApiClient) error {
+	return c.DoRequest(context.Background(), model.HTTPGet, "http://dummy.url", nil, ContentTypeJSON, nil, nil, &dummyOutput{})
+}
+
+func TestRateLimitedHTTPApiClient_DoRequest_FailMode(t *testing.T) {
+	now := mockClock(t)
+	inner := mocks.NewHTTPApiClient(t)
+	inner.On("DoRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+
+	c := NewRateLimitedHTTPApiClient(inner, RateLimitConfig{RequestsPerSecond: 1, Burst: 2, Mode: RateLimitModeFail})
+
+	for i := 0; i < 2; i++ {
+		if err := doRateLimitedRequest(c); err != nil {
+			t.Fatalf("DoRequest() call %d error = %v, want nil", i, err)
+		}
+	}
+	if err := doRateLimitedRequest(c); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("DoRequest() error = %v, want %v", err, ErrRateLimitExceeded)
+	}
+
+	*now = now.Add(time.Second)
+	if err := doRateLimitedRequest(c); err != nil {
+		t.Fatalf("DoRequest() after refill error = %v, want nil", err)
+	}
+}
+
+func TestRateLimitedHTTPApiClient_DoRequest_WaitMode(t *testing.T) {
+	mockClock(t)
+	delays := mockSleep(t)
+	inner := mocks.NewHTTPApiClient(t)
+	inner.On("DoRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+
+	c := NewRateLimitedHTTPApiClient(inner, RateLimitConfig{RequestsPerSecond: 2, Burst: 1, Mode: RateLimitModeWait})
+
+	for i := 0; i < 3; i++ {
+		if err := doRateLimitedRequest(c); err != nil {
+			t.Fatalf("DoRequest() call %d error = %v, want nil", i, err)
+		}
+	}
+	want := []time.Duration{500 * time.Millisecond, time.Second}
+	if len(*delays) != len(want) {
+		t.Fatalf("DoRequest() delays = %v, want %v", *delays, want)
+	}
+	for i := range want {
+		if (*delays)[i] != want[i] {
+			t.Errorf("DoRequest() delay[%d] = %v, want %v", i, (*delays)[i], want[i])
+		}
+	}
+}
+
+func TestRateLimitedHTTPApiClient_DoRequest_WaitModeContextCanceled(t *testing.T) {
+	mockClock(t)
+	inner := mocks.NewHTTPApiClient(t)
+	inner.On("DoRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	c := NewRateLimitedHTTPApiClient(inner, RateLimitConfig{RequestsPerSecond: 0.01, Burst: 1, Mode: RateLimitModeWait})
+	if err := doRateLimitedRequest(c); err != nil {
+		t.Fatalf("DoRequest() error = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.DoRequest(ctx, model.HTTPGet, "http://dummy.url", nil, ContentTypeJSON, nil, nil, &dummyOutput{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DoRequest() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRateLimitedHTTPApiClient_DoRequest_AdaptsToRateLimitResponses(t *testing.T) {
+	mockClock(t)
+	mockSleep(t)
+	rateLimitedErr := &commons.ResourceError{Status: http.StatusTooManyRequests, Message: "API rate limit exceeded"}
+	inner := mocks.NewHTTPApiClient(t)
+	inner.On("DoRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(rateLimitedErr).Times(3)
+	inner.On("DoRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	c := NewRateLimitedHTTPApiClient(inner, RateLimitConfig{RequestsPerSecond: 8, Burst: 8, MinRequestsPerSecond: 2})
+
+	wantRates := []float64{4, 2, 2}
+	for i, want := range wantRates {
+		if err := doRateLimitedRequest(c); !errors.Is(err, rateLimitedErr) {
+			t.Fatalf("DoRequest() call %d error = %v, want %v", i, err, rateLimitedErr)
+		}
+		if got := c.RequestsPerSecond(); got != want {
+			t.Errorf("RequestsPerSecond() after 429 #%d = %v, want %v", i, got, want)
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		if err := doRateLimitedRequest(c); err != nil {
+			t.Fatalf("DoRequest() error = %v, want nil", err)
+		}
+	}
+	if got := c.RequestsPerSecond(); got != 8 {
+		t.Errorf("RequestsPerSecond() after recovery = %v, want 8", got)
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_RateLimitsRetries(t *testing.T) {
+	mockClock(t)
+	delays := mockSleep(t)
+	calls := &atomic.Int32{}
+	server := newStatusSequenceServer(t, calls, []int{http.StatusTooManyRequests, http.StatusOK}, map[string]string{"Retry-After": "0"})
+	limiter := NewRateLimiter(RateLimitConfig{RequestsPerSecond: 8, Burst: 1, MinRequestsPerSecond: 1})
+	c := NewCustomHTTPApiClient(DefaultRetryPolicy()).WithRateLimiter(limiter)
+
+	err := c.DoRequest(context.Background(), model.HTTPGet, server.URL, nil, ContentTypeJSON, nil, nil, &dummyOutput{})
+
+	if err != nil {
+		t.Fatalf("DoRequest() error = %v, want nil", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("DoRequest() calls = %d, want 2", calls.Load())
+	}
+	// the retry waits for Retry-After, then for a token of the bucket refilling at the halved rate
+	want := []time.Duration{0, 250 * time.Millisecond}
+	if len(*delays) != len(want) || (*delays)[0] != want[0] || (*delays)[1] != want[1] {
+		t.Errorf("DoRequest() delays = %v, want %v", *delays, want)
+	}
+	if got, wantRate := limiter.RequestsPerSecond(), 4+8*rateLimitRecoveryFactor; got != wantRate {
+		t.Errorf("RequestsPerSecond() = %v, want %v", got, wantRate)
+	}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	// for testing purposes
+	randInt64N       = rand.Int64N
+	timeNow          = time.Now
+	sleepWithContext = func(ctx context.Context, delay time.Duration) error {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		}
+	}
+)
+
+// RetryPolicy defines how many times and how long the client waits before retrying a request that
+// failed due to rate limiting (429), a transient server error (500, 502, 503 and 504) or a transport error such as a
+// connection reset. Server and transport errors are only retried for idempotent HTTP methods, unless the request is
+// marked with NonIdempotent. A request is not retried when its Retry-After asks to wait longer than MaxDelay.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+	}
+}
+
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+func (p RetryPolicy) shouldRetry(idempotent bool, attempt int, statusCode int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return commons.IsRetryableStatus(statusCode) && idempotent
+}
+
+// shouldRetryTransportError tells whether to retry a request that got no response, which the server may still have
+// processed.
+func (p RetryPolicy) shouldRetryTransportError(idempotent bool, attempt int) bool {
+	return attempt < p.MaxAttempts && idempotent
+}
+
+// delay returns how long to wait before the next attempt. A valid Retry-After header wins over the backoff, and false
+// is returned when it asks for longer than MaxDelay, as retrying any earlier would just be rate limited again.
+func (p RetryPolicy) delay(attempt int, retryAfter string) (time.Duration, bool) {
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		return d, p.MaxDelay <= 0 || d <= p.MaxDelay
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns an exponential backoff with jitter for attempt, capped by MaxDelay.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.BaseDelay << (attempt - 1)
+	if backoff <= 0 || (p.MaxDelay > 0 && backoff > p.MaxDelay) {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	half := int64(backoff / 2)
+	return time.Duration(half + randInt64N(half+1))
+}
+
+func parseRetryAfter(retryAfter string) (time.Duration, bool) {
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		d := date.Sub(timeNow())
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+type nonIdempotentKey struct{}
+
+// NonIdempotent marks the requests made with the returned context as unsafe to repeat whatever their method, e.g. a
+// PUT that moves items, so they are not retried on server errors. Rate limited requests are still retried, as the API
+// didn't process them.
+func NonIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nonIdempotentKey{}, true)
+}
+
+func isIdempotent(ctx context.Context, method model.HTTPMethod) bool {
+	if nonIdempotent, _ := ctx.Value(nonIdempotentKey{}).(bool); nonIdempotent {
+		return false
+	}
+	return isIdempotentMethod(method)
+}
+
+func isIdempotentMethod(method model.HTTPMethod) bool {
+	switch method.String() {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
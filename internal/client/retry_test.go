@@ -0,0 +1,309 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type dummyOutput struct {
+	Value string `json:"value"`
+}
+
+func mockSleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+	originalSleep := sleepWithContext
+	t.Cleanup(func() {
+		sleepWithContext = originalSleep
+	})
+	delays := &[]time.Duration{}
+	sleepWithContext = func(ctx context.Context, delay time.Duration) error {
+		*delays = append(*delays, delay)
+		return ctx.Err()
+	}
+	return delays
+}
+
+func newStatusSequenceServer(t *testing.T, calls *atomic.Int32, statuses []int, headers map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		call := int(calls.Add(1))
+		status := statuses[len(statuses)-1]
+		if call <= len(statuses) {
+			status = statuses[call-1]
+		}
+		for key, value := range headers {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(status)
+		if status < 300 {
+			_, _ = w.Write([]byte(`{"value":"ok"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"status":%d,"message":"failure"}`, status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCustomHTTPApiClient_DoRequest_Retry(t *testing.T) {
+	retryPolicy := RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+	}
+	tests := []struct {
+		name          string
+		method        model.HTTPMethod
+		nonIdempotent bool
+		statuses      []int
+		headers       map[string]string
+		wantCalls     int32
+		wantStatus    int
+		wantDelays    []time.Duration
+		wantErr       bool
+		checkDelays   bool
+	}{
+		{
+			name:        "should not retry when response is successful",
+			method:      model.HTTPGet,
+			statuses:    []int{http.StatusOK},
+			wantCalls:   1,
+			wantDelays:  []time.Duration{},
+			checkDelays: true,
+		},
+		{
+			name:        "should retry rate limited request honoring Retry-After header",
+			method:      model.HTTPGet,
+			statuses:    []int{http.StatusTooManyRequests, http.StatusOK},
+			headers:     map[string]string{"Retry-After": "2"},
+			wantCalls:   2,
+			wantDelays:  []time.Duration{2 * time.Second},
+			checkDelays: true,
+		},
+		{
+			name:        "should not retry rate limited request when Retry-After is longer than max delay",
+			method:      model.HTTPGet,
+			statuses:    []int{http.StatusTooManyRequests, http.StatusOK},
+			headers:     map[string]string{"Retry-After": "30"},
+			wantCalls:   1,
+			wantStatus:  http.StatusTooManyRequests,
+			wantDelays:  []time.Duration{},
+			wantErr:     true,
+			checkDelays: true,
+		},
+		{
+			name:      "should retry rate limited request for non idempotent methods",
+			method:    model.HTTPMethod(http.MethodPost),
+			statuses:  []int{http.StatusTooManyRequests, http.StatusOK},
+			headers:   map[string]string{"Retry-After": "1"},
+			wantCalls: 2,
+		},
+		{
+			name:      "should retry idempotent request on server errors",
+			method:    model.HTTPGet,
+			statuses:  []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			wantCalls: 3,
+		},
+		{
+			name:       "should return error when max attempts are exhausted",
+			method:     model.HTTPGet,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantCalls:  3,
+			wantStatus: http.StatusServiceUnavailable,
+			wantErr:    true,
+		},
+		{
+			name:       "should not retry non idempotent request on server errors",
+			method:     model.HTTPMethod(http.MethodPost),
+			statuses:   []int{http.StatusGatewayTimeout, http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusGatewayTimeout,
+			wantErr:    true,
+		},
+		{
+			name:          "should not retry request marked as non idempotent on server errors",
+			method:        model.HTTPPut,
+			nonIdempotent: true,
+			statuses:      []int{http.StatusBadGateway, http.StatusOK},
+			wantCalls:     1,
+			wantStatus:    http.StatusBadGateway,
+			wantErr:       true,
+		},
+		{
+			name:          "should retry rate limited request marked as non idempotent",
+			method:        model.HTTPPut,
+			nonIdempotent: true,
+			statuses:      []int{http.StatusTooManyRequests, http.StatusOK},
+			headers:       map[string]string{"Retry-After": "1"},
+			wantCalls:     2,
+		},
+		{
+			name:       "should not retry client errors",
+			method:     model.HTTPGet,
+			statuses:   []int{http.StatusNotFound, http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusNotFound,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delays := mockSleep(t)
+			calls := &atomic.Int32{}
+			server := newStatusSequenceServer(t, calls, tt.statuses, tt.headers)
+			c := CustomHTTPApiClient{httpClient: server.Client(), retryPolicy: retryPolicy}
+
+			ctx := context.Background()
+			if tt.nonIdempotent {
+				ctx = NonIdempotent(ctx)
+			}
+			output := &dummyOutput{}
+			err := c.DoRequest(ctx, tt.method, server.URL, nil, ContentTypeJSON, nil, nil, output)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DoRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("DoRequest() calls = %d, want %d", got, tt.wantCalls)
+			}
+			if tt.wantErr {
+				var resErr *commons.ResourceError
+				if !errors.As(err, &resErr) {
+					t.Fatalf("DoRequest() error of unexpected type %T, want *commons.ResourceError", err)
+				}
+				if resErr.Status != tt.wantStatus {
+					t.Errorf("DoRequest() error status = %d, want %d", resErr.Status, tt.wantStatus)
+				}
+			} else if output.Value != "ok" {
+				t.Errorf("DoRequest() output = %+v, want value ok", output)
+			}
+			if tt.checkDelays && len(*delays) != len(tt.wantDelays) {
+				t.Fatalf("DoRequest() delays = %v, want %v", *delays, tt.wantDelays)
+			}
+			for i := range tt.wantDelays {
+				if (*delays)[i] != tt.wantDelays[i] {
+					t.Errorf("DoRequest() delay[%d] = %v, want %v", i, (*delays)[i], tt.wantDelays[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_RetryContextCanceled(t *testing.T) {
+	calls := &atomic.Int32{}
+	server := newStatusSequenceServer(t, calls, []int{http.StatusTooManyRequests}, map[string]string{"Retry-After": "5"})
+	c := CustomHTTPApiClient{httpClient: server.Client(), retryPolicy: DefaultRetryPolicy()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := c.DoRequest(ctx, model.HTTPGet, server.URL, nil, ContentTypeJSON, nil, nil, &dummyOutput{})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DoRequest() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("DoRequest() took %v, expected to be aborted by context", elapsed)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("DoRequest() calls = %d, want 1", got)
+	}
+}
+
+func TestCustomHTTPApiClient_DoRequest_RetryTransportError(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    model.HTTPMethod
+		wantCalls int32
+		wantErr   bool
+	}{
+		{name: "should retry idempotent request when connection is reset", method: model.HTTPGet, wantCalls: 2},
+		{name: "should not retry non idempotent request when connection is reset", method: model.HTTPMethod(http.MethodPost), wantCalls: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delays := mockSleep(t)
+			calls := &atomic.Int32{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					conn, _, err := http.NewResponseController(w).Hijack()
+					if err != nil {
+						t.Errorf("hijacking connection error = %v", err)
+						return
+					}
+					_ = conn.Close()
+					return
+				}
+				_, _ = w.Write([]byte(`{"value":"ok"}`))
+			}))
+			t.Cleanup(server.Close)
+			c := CustomHTTPApiClient{httpClient: server.Client(), retryPolicy: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}}
+
+			output := &dummyOutput{}
+			err := c.DoRequest(context.Background(), tt.method, server.URL, nil, ContentTypeJSON, nil, nil, output)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DoRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("DoRequest() calls = %d, want %d", got, tt.wantCalls)
+			}
+			if got := int32(len(*delays)); got != tt.wantCalls-1 {
+				t.Errorf("DoRequest() delays = %v, want %d", *delays, tt.wantCalls-1)
+			}
+			if !tt.wantErr && output.Value != "ok" {
+				t.Errorf("DoRequest() output = %+v, want value ok", output)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	originalRandInt64N := randInt64N
+	originalTimeNow := timeNow
+	defer func() {
+		randInt64N = originalRandInt64N
+		timeNow = originalTimeNow
+	}()
+	randInt64N = func(n int64) int64 {
+		return n - 1
+	}
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return now
+	}
+
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 3 * time.Second}
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		want       time.Duration
+		wantRetry  bool
+	}{
+		{name: "should use base delay with jitter on first attempt", attempt: 1, want: time.Second, wantRetry: true},
+		{name: "should double delay on each attempt", attempt: 2, want: 2 * time.Second, wantRetry: true},
+		{name: "should cap delay at max delay", attempt: 4, want: 3 * time.Second, wantRetry: true},
+		{name: "should honor Retry-After in seconds", attempt: 1, retryAfter: "2", want: 2 * time.Second, wantRetry: true},
+		{name: "should honor Retry-After as http date", attempt: 1, retryAfter: now.Add(2 * time.Second).Format(http.TimeFormat), want: 2 * time.Second, wantRetry: true},
+		{name: "should not retry when Retry-After is longer than max delay", attempt: 1, retryAfter: "3600", want: time.Hour},
+		{name: "should ignore invalid Retry-After", attempt: 1, retryAfter: "soon", want: time.Second, wantRetry: true},
+		{name: "should ignore negative Retry-After", attempt: 2, retryAfter: "-1", want: 2 * time.Second, wantRetry: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, retry := policy.delay(tt.attempt, tt.retryAfter)
+			if got != tt.want || retry != tt.wantRetry {
+				t.Errorf("delay() = %v, %v, want %v, %v", got, retry, tt.want, tt.wantRetry)
+			}
+		})
+	}
+}
@@ -1,14 +1,21 @@
 package client
 
-import "jezz-go-spotify-integration/internal/model"
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/model"
+)
 
+// HTTPApiClient executes requests against the Spotify API. When requestBody is not nil it is sent encoded as JSON,
+// and when responseTypedOutput is not nil the response body is decoded into it; empty response bodies are ignored.
 type HTTPApiClient interface {
 	DoRequest(
+		ctx context.Context,
 		method model.HTTPMethod,
 		url string,
 		queryParams *model.QueryParams,
 		contentType string,
 		accessToken *model.AccessToken,
+		requestBody any,
 		responseTypedOutput any,
 	) error
 }
@@ -1,37 +1,204 @@
 package commons
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+)
 
 // jsonMarshal is a variable that holds the json.Marshal function.
 // This allows us to mock it in tests.
 var jsonMarshal = json.Marshal
 
+// Sentinel errors to tell failures apart with errors.Is, whatever layer wrapped them.
+var (
+	ErrBadRequest         = errors.New("bad request")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrForbidden          = errors.New("forbidden")
+	ErrNotFound           = errors.New("not found")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrServerError        = errors.New("server error")
+	ErrUnexpectedResponse = errors.New("unexpected response")
+	ErrInvalidMarket      = errors.New("invalid market")
+	ErrInvalidReference   = errors.New("invalid reference")
+)
+
+// RetryableError is implemented by errors that know whether repeating the request that caused them may succeed.
+type RetryableError interface {
+	error
+	Retryable() bool
+}
+
+// IsRetryable reports whether the operation that failed with err may succeed if attempted again: rate limiting,
+// transient server errors and network timeouts are, anything else isn't.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var retryableErr RetryableError
+	if errors.As(err, &retryableErr) {
+		return retryableErr.Retryable()
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsRetryableStatus reports whether a response with the given HTTP status may succeed if the request is sent again.
+func IsRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func statusSentinel(status int) error {
+	switch {
+	case status == http.StatusBadRequest:
+		return ErrBadRequest
+	case status == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case status == http.StatusForbidden:
+		return ErrForbidden
+	case status == http.StatusNotFound:
+		return ErrNotFound
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case status >= http.StatusInternalServerError:
+		return ErrServerError
+	default:
+		return nil
+	}
+}
+
+// ResourceError is a non successful answer of the API. Endpoint and RequestID identify the call that failed.
 type ResourceError struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
+	Status    int    `json:"status"`
+	Message   string `json:"message"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
 }
 
 func (e ResourceError) Error() string {
+	e.Message = Redact(e.Message)
 	if body, err := jsonMarshal(e); err == nil {
 		return string(body)
 	}
 	return "resource error, no details provided"
 }
 
+// Is matches the sentinel error of the HTTP status, e.g. a 404 is ErrNotFound.
+func (e ResourceError) Is(target error) bool {
+	sentinel := statusSentinel(e.Status)
+	return sentinel != nil && sentinel == target
+}
+
+func (e ResourceError) Retryable() bool {
+	return IsRetryableStatus(e.Status)
+}
+
+// AppError is a failure handling a request or its response. When it comes from an HTTP exchange, Status, Endpoint
+// and RequestID describe it, and Cause, if any, is the sentinel error it matches.
 type AppError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Details string `json:"details"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Details   string `json:"details"`
+	Status    int    `json:"status,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	Cause     error  `json:"-"`
 }
 
 func (e AppError) Error() string {
+	// the message and details may echo what was sent, such as the client credentials
+	e.Message, e.Details = Redact(e.Message), Redact(e.Details)
 	if body, err := jsonMarshal(e); err == nil {
 		return string(body)
 	}
 	return "app error, no details provided"
 }
 
+// Is matches the sentinel error of the HTTP status when there is no explicit cause.
+func (e AppError) Is(target error) bool {
+	if e.Cause != nil {
+		return false
+	}
+	sentinel := statusSentinel(e.Status)
+	return sentinel != nil && sentinel == target
+}
+
+func (e AppError) Unwrap() error {
+	return e.Cause
+}
+
+func (e AppError) Retryable() bool {
+	return IsRetryableStatus(e.Status)
+}
+
 type AuthenticationError struct {
 	Err            string `json:"error"`
 	ErrDescription string `json:"error_description"`
 }
+
+// MissingIDsError reports the requested IDs Spotify answered with a null entry, e.g. because they don't exist
+// or aren't available in the requested market.
+type MissingIDsError struct {
+	Resource string   `json:"resource"`
+	IDs      []string `json:"ids"`
+}
+
+func (e MissingIDsError) Error() string {
+	if body, err := jsonMarshal(e); err == nil {
+		return string(body)
+	}
+	return "missing ids error, no details provided"
+}
+
+func (e MissingIDsError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// InvalidMarketError reports a market that is unknown or not served by Spotify, along with the closest markets
+// to what was given, if any.
+type InvalidMarketError struct {
+	Input       string   `json:"input"`
+	Reason      string   `json:"reason"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+func (e InvalidMarketError) Error() string {
+	if body, err := jsonMarshal(e); err == nil {
+		return string(body)
+	}
+	return "invalid market error, no details provided"
+}
+
+func (e InvalidMarketError) Is(target error) bool {
+	return target == ErrInvalidMarket
+}
+
+// InvalidReferenceError reports an ID, Spotify URI or share link that couldn't be parsed, or that points to another
+// type of entity than the expected one.
+type InvalidReferenceError struct {
+	Input    string `json:"input"`
+	Expected string `json:"expected,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+func (e InvalidReferenceError) Error() string {
+	if body, err := jsonMarshal(e); err == nil {
+		return string(body)
+	}
+	return "invalid reference error, no details provided"
+}
+
+func (e InvalidReferenceError) Is(target error) bool {
+	return target == ErrInvalidReference
+}
@@ -1,7 +1,11 @@
 package commons
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
 	"testing"
 )
 
@@ -76,3 +80,95 @@ func TestAppError_Error_MarshalErrorPath(t *testing.T) {
 		t.Errorf("Expected error string '%s' when json.Marshal fails, but got '%s'", expectedErrorMessage, actualError)
 	}
 }
+
+func TestMissingIDsError_Error(t *testing.T) {
+	t.Run("should marshal the missing ids", func(t *testing.T) {
+		err := MissingIDsError{Resource: "album", IDs: []string{"id-1", "id-2"}}
+		expectedJSON := `{"resource":"album","ids":["id-1","id-2"]}`
+		if err.Error() != expectedJSON {
+			t.Errorf("Expected error string '%s', but got '%s'", expectedJSON, err.Error())
+		}
+	})
+	t.Run("should return generic message when marshal fails", func(t *testing.T) {
+		originalJSONMarshal := jsonMarshal
+		defer func() {
+			jsonMarshal = originalJSONMarshal
+		}()
+		jsonMarshal = func(_ interface{}) ([]byte, error) {
+			return nil, errors.New("mock marshal error")
+		}
+		err := MissingIDsError{Resource: "album", IDs: []string{"id-1"}}
+		if err.Error() != "missing ids error, no details provided" {
+			t.Errorf("Expected generic error string, but got '%s'", err.Error())
+		}
+	})
+}
+
+func TestErrors_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{name: "should match not found resource error", err: ResourceError{Status: 404}, target: ErrNotFound, want: true},
+		{name: "should match rate limited resource error", err: &ResourceError{Status: 429}, target: ErrRateLimited, want: true},
+		{name: "should match unauthorized resource error", err: ResourceError{Status: 401}, target: ErrUnauthorized, want: true},
+		{name: "should match forbidden resource error", err: ResourceError{Status: 403}, target: ErrForbidden, want: true},
+		{name: "should match bad request resource error", err: ResourceError{Status: 400}, target: ErrBadRequest, want: true},
+		{name: "should match server error resource error", err: ResourceError{Status: 502}, target: ErrServerError, want: true},
+		{name: "should not match another status", err: ResourceError{Status: 404}, target: ErrForbidden},
+		{name: "should match through wrapping", err: fmt.Errorf("error getting album - %w", ResourceError{Status: 404}), target: ErrNotFound, want: true},
+		{name: "should match app error by status", err: AppError{Status: 401}, target: ErrUnauthorized, want: true},
+		{name: "should match app error by cause over status", err: AppError{Status: 400, Cause: ErrUnauthorized}, target: ErrUnauthorized, want: true},
+		{name: "should not match app error status when there is a cause", err: AppError{Status: 400, Cause: ErrUnauthorized}, target: ErrBadRequest},
+		{name: "should match missing ids as not found", err: MissingIDsError{Resource: "album"}, target: ErrNotFound, want: true},
+		{name: "should match invalid market", err: InvalidMarketError{Input: "x"}, target: ErrInvalidMarket, want: true},
+		{name: "should match invalid reference", err: InvalidReferenceError{Input: "x"}, target: ErrInvalidReference, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.err, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "should not retry nil", err: nil},
+		{name: "should retry rate limited", err: ResourceError{Status: 429}, want: true},
+		{name: "should retry unavailable service", err: fmt.Errorf("wrapped - %w", &ResourceError{Status: 503}), want: true},
+		{name: "should not retry not found", err: ResourceError{Status: 404}},
+		{name: "should not retry unexpected response", err: AppError{Status: 200, Cause: ErrUnexpectedResponse}},
+		{name: "should retry rate limited sentinel", err: fmt.Errorf("client side - %w", ErrRateLimited), want: true},
+		{name: "should retry network timeouts", err: &net.DNSError{IsTimeout: true}, want: true},
+		{name: "should not retry canceled context", err: fmt.Errorf("wrapped - %w", context.Canceled)},
+		{name: "should not retry validation errors", err: InvalidMarketError{Input: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppError_Error_RedactsSecrets(t *testing.T) {
+	RegisterSecret("dummy-client")
+	RegisterSecret("dummy-client-secret")
+	RegisterSecret("abc")
+
+	var got AppError
+	err := json.Unmarshal([]byte(AppError{Status: 400, Message: "invalid_client", Details: "client_secret=dummy-client-secret&scope=abc"}.Error()), &got)
+
+	if err != nil || got.Details != "client_secret="+RedactedValue+"&scope=abc" {
+		t.Errorf("Error() details = %q, %v, want only the registered secret redacted", got.Details, err)
+	}
+}
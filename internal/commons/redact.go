@@ -0,0 +1,45 @@
+package commons
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces secrets wherever they would be shown.
+const RedactedValue = "<redacted>"
+
+// minSecretLength keeps short values, which would match all over unrelated text, from being registered as secrets
+const minSecretLength = 4
+
+var secrets = struct {
+	sync.RWMutex
+	values []string
+}{}
+
+// RegisterSecret makes Redact hide value from then on, e.g. a client secret once its config is loaded.
+func RegisterSecret(value string) {
+	if len(value) < minSecretLength {
+		return
+	}
+	secrets.Lock()
+	defer secrets.Unlock()
+	if slices.Contains(secrets.values, value) {
+		return
+	}
+	// longer secrets go first, so one containing another is redacted whole
+	i, _ := slices.BinarySearchFunc(secrets.values, value, func(registered string, v string) int {
+		return len(v) - len(registered)
+	})
+	secrets.values = slices.Insert(secrets.values, i, value)
+}
+
+// Redact replaces the registered secrets found in text with RedactedValue.
+func Redact(text string) string {
+	secrets.RLock()
+	defer secrets.RUnlock()
+	for _, secret := range secrets.values {
+		text = strings.ReplaceAll(text, secret, RedactedValue)
+	}
+	return text
+}
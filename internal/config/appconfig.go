@@ -1,11 +1,21 @@
 package config
 
 type AppConfig struct {
-	Client CliConfig `json:"client" yaml:"client" validate:"required"`
+	Client CliConfig       `json:"client" yaml:"client" validate:"required"`
+	User   *UserAuthConfig `json:"user,omitempty" yaml:"user,omitempty"`
 }
 type CliConfig struct {
-	BaseURL     string `json:"base_url" yaml:"base_url" validate:"required,url"`
-	AccountsURL string `json:"accounts_url" yaml:"accounts_url" validate:"required,url"`
+	BaseURL     string `json:"base_url" yaml:"base_url" env:"SPOTIFY_BASE_URL" validate:"required,url"`
+	AccountsURL string `json:"accounts_url" yaml:"accounts_url" env:"SPOTIFY_ACCOUNTS_URL" validate:"required,url"`
+	// StrictDecoding fails responses with fields the model doesn't map, see model.UnmarshalStrict
+	StrictDecoding bool `json:"strict_decoding" yaml:"strict_decoding" env:"SPOTIFY_STRICT_DECODING"`
+}
+
+// UserAuthConfig enables the Authorization Code with PKCE flow, with the user session persisted in TokenStorePath.
+type UserAuthConfig struct {
+	RedirectURL    string   `json:"redirect_url" yaml:"redirect_url" env:"SPOTIFY_REDIRECT_URL" validate:"required,url"`
+	Scopes         []string `json:"scopes" yaml:"scopes" env:"SPOTIFY_SCOPES"`
+	TokenStorePath string   `json:"token_store_path" yaml:"token_store_path" env:"SPOTIFY_TOKEN_STORE_PATH"`
 }
 
 type AppConfigLoader struct{}
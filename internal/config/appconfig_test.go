@@ -45,6 +45,32 @@ func TestAppConfigLoader_Load(t *testing.T) {
 			want:    AppConfig{},
 			wantErr: true,
 		},
+		{
+			name: "should load yaml app config with user authentication with success",
+			fields: fields{
+				configDataFile: "app-config-with-user.yml",
+			},
+			want: AppConfig{
+				Client: CliConfig{
+					BaseURL:     "http://dummy.url",
+					AccountsURL: "http://dummy.url",
+				},
+				User: &UserAuthConfig{
+					RedirectURL:    "http://127.0.0.1:8888/callback",
+					Scopes:         []string{"playlist-read-private", "playlist-modify-private"},
+					TokenStorePath: "/tmp/dummy-session.json",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return error when loading yaml app config with user authentication that misses redirect url",
+			fields: fields{
+				configDataFile: "app-config-with-user-missing-redirect-url.yml",
+			},
+			want:    AppConfig{},
+			wantErr: true,
+		},
 		{
 			name: "should load json app config with success",
 			fields: fields{
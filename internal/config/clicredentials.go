@@ -1,8 +1,9 @@
 package config
 
+// CliCredentials may be given as references, see ResolveReference.
 type CliCredentials struct {
-	ID     string `json:"client_id" yaml:"client_id" validate:"required"`
-	Secret string `json:"client_secret" yaml:"client_secret" validate:"required"`
+	ID     string `json:"client_id" yaml:"client_id" env:"SPOTIFY_CLIENT_ID" ref:"true" validate:"required"`
+	Secret string `json:"client_secret" yaml:"client_secret" env:"SPOTIFY_CLIENT_SECRET" ref:"true" secret:"true" validate:"required"`
 }
 
 type CliCredentialsConfigLoader struct{}
@@ -12,6 +13,9 @@ func (a CliCredentialsConfigLoader) Load(cliCredConfigData []byte) (CliCredentia
 	if err := loadConfig(cliCredConfigData, &config); err != nil {
 		return CliCredentials{}, err
 	}
+	if err := ResolveReferences(&config); err != nil {
+		return CliCredentials{}, err
+	}
 	if err := validate(config); err != nil {
 		return CliCredentials{}, err
 	}
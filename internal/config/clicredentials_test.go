@@ -62,7 +62,27 @@ func TestCliCredentialsConfigLoader_Load(t *testing.T) {
 			want:    CliCredentials{},
 			wantErr: true,
 		},
+		{
+			name: "should resolve client credentials given as references",
+			fields: fields{
+				configDataFile: "cli-credentials-config-references.yml",
+			},
+			want: CliCredentials{
+				ID:     "DUMMY_ENV_CLIENT_ID",
+				Secret: "DUMMY_FILE_CLIENT_SECRET",
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return error when a client credentials reference can't be resolved",
+			fields: fields{
+				configDataFile: "cli-credentials-config-missing-reference.yml",
+			},
+			want:    CliCredentials{},
+			wantErr: true,
+		},
 	}
+	t.Setenv("DUMMY_SPOTIFY_CLIENT_ID", "DUMMY_ENV_CLIENT_ID")
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.fields.configDataFile == "" {
@@ -0,0 +1,304 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// for testing purposes
+	osReadFile = os.ReadFile
+)
+
+// Layer overrides the fields of a config it sets, leaving the rest as the previous layers left them.
+type Layer[T any] interface {
+	Name() string
+	Apply(config *T) error
+}
+
+// LayeredLoader is a Loader taking configData as the defaults, which its layers override in order. Only the merged
+// config is validated, so each layer may set just a few fields.
+type LayeredLoader[T any] struct {
+	layers         []Layer[T]
+	keepReferences bool
+}
+
+func NewLayeredLoader[T any](layers ...Layer[T]) LayeredLoader[T] {
+	return LayeredLoader[T]{layers: layers}
+}
+
+// WithoutReferences returns a copy of the loader that leaves the references as they are, e.g. to show the config
+// without running its exec: references.
+func (l LayeredLoader[T]) WithoutReferences() LayeredLoader[T] {
+	l.keepReferences = true
+	return l
+}
+
+func (l LayeredLoader[T]) Load(configData []byte) (T, error) {
+	var config T
+	if err := decodeStrict(configData, &config); err != nil {
+		return config, fmt.Errorf("error loading default config - %w", err)
+	}
+	for _, layer := range l.layers {
+		if err := layer.Apply(&config); err != nil {
+			var zero T
+			return zero, fmt.Errorf("error loading %s - %w", layer.Name(), err)
+		}
+	}
+	// references are resolved once merged, so any layer may override them without running them
+	if !l.keepReferences {
+		if err := ResolveReferences(&config); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	if err := validate(config); err != nil {
+		var zero T
+		return zero, err
+	}
+	return config, nil
+}
+
+// decodeStrict decodes YAML or JSON over config, failing on keys config doesn't have so typos don't go unnoticed.
+func decodeStrict(data []byte, config any) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("error while unmarshalling config - %w", err)
+	}
+	return nil
+}
+
+type dataLayer[T any] struct {
+	name    string
+	data    []byte
+	section string
+}
+
+// DataLayer decodes YAML or JSON data over the config.
+func DataLayer[T any](name string, data []byte) Layer[T] {
+	return dataLayer[T]{name: name, data: data}
+}
+
+// SectionLayer decodes YAML or JSON data over the field of the config the section key names, for files holding just
+// a part of it.
+func SectionLayer[T any](name string, section string, data []byte) Layer[T] {
+	return dataLayer[T]{name: name, data: data, section: section}
+}
+
+func (l dataLayer[T]) Name() string {
+	return l.name
+}
+
+func (l dataLayer[T]) Apply(config *T) error {
+	if l.section == "" {
+		return decodeStrict(l.data, config)
+	}
+	field, err := fieldByPath(reflect.ValueOf(config).Elem(), l.section)
+	if err != nil {
+		return err
+	}
+	return decodeStrict(l.data, field.Addr().Interface())
+}
+
+type fileLayer[T any] struct {
+	path     string
+	optional bool
+}
+
+// FileLayer decodes the YAML or JSON file at path over the config.
+func FileLayer[T any](path string) Layer[T] {
+	return fileLayer[T]{path: path}
+}
+
+// OptionalFileLayer is a FileLayer skipped when the file doesn't exist, e.g. for a default location.
+func OptionalFileLayer[T any](path string) Layer[T] {
+	return fileLayer[T]{path: path, optional: true}
+}
+
+func (l fileLayer[T]) Name() string {
+	return "config file " + l.path
+}
+
+func (l fileLayer[T]) Apply(config *T) error {
+	data, err := osReadFile(l.path)
+	if l.optional && errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading config file - %w", err)
+	}
+	return decodeStrict(data, config)
+}
+
+type envLayer[T any] struct {
+	lookupEnv func(key string) (string, bool)
+}
+
+// EnvLayer sets the fields tagged with `env:"NAME"` whose environment variable is set. Lists take comma separated
+// values.
+func EnvLayer[T any](lookupEnv func(key string) (string, bool)) Layer[T] {
+	return envLayer[T]{lookupEnv: lookupEnv}
+}
+
+func (l envLayer[T]) Name() string {
+	return "environment"
+}
+
+func (l envLayer[T]) Apply(config *T) error {
+	for env, path := range EnvVars[T]() {
+		if value, ok := l.lookupEnv(env); ok {
+			if err := SetPath(config, path, value); err != nil {
+				return fmt.Errorf("error reading %s - %w", env, err)
+			}
+		}
+	}
+	return nil
+}
+
+type valuesLayer[T any] struct {
+	name   string
+	values map[string]string
+}
+
+// ValuesLayer sets fields by their path, such as "client.base_url", e.g. from command line flags.
+func ValuesLayer[T any](name string, values map[string]string) Layer[T] {
+	return valuesLayer[T]{name: name, values: values}
+}
+
+func (l valuesLayer[T]) Name() string {
+	return l.name
+}
+
+func (l valuesLayer[T]) Apply(config *T) error {
+	for path, value := range l.values {
+		if err := SetPath(config, path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnvVars maps the environment variables of the fields of T tagged with `env:"NAME"` to the paths of the fields.
+func EnvVars[T any]() map[string]string {
+	envVars := map[string]string{}
+	collectEnvVars(reflect.TypeFor[T](), "", envVars)
+	return envVars
+}
+
+func collectEnvVars(t reflect.Type, prefix string, envVars map[string]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		key, inline := yamlKey(field)
+		path := prefix
+		if !inline {
+			path = joinPath(prefix, key)
+		}
+		if env := field.Tag.Get("env"); env != "" {
+			envVars[env] = path
+		}
+		collectEnvVars(field.Type, path, envVars)
+	}
+}
+
+// SetPath sets the field at path, given by its YAML keys separated by dots, parsing value for its type. Structs
+// behind nil pointers along the path are allocated.
+func SetPath[T any](config *T, path string, value string) error {
+	field, err := fieldByPath(reflect.ValueOf(config).Elem(), path)
+	if err != nil {
+		return err
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		parsed, pErr := strconv.ParseBool(strings.TrimSpace(value))
+		if pErr != nil {
+			return fmt.Errorf("%s must be true or false, got %q", path, value)
+		}
+		field.SetBool(parsed)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("%s can't be set from text", path)
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("%s can't be set from text", path)
+	}
+	return nil
+}
+
+func fieldByPath(v reflect.Value, path string) (reflect.Value, error) {
+	for _, key := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		field, ok := fieldByKey(v, key)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("unknown config %q", path)
+		}
+		v = field
+	}
+	return v, nil
+}
+
+func fieldByKey(v reflect.Value, key string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	for i := range v.NumField() {
+		fieldKey, inline := yamlKey(v.Type().Field(i))
+		if inline {
+			if field, ok := fieldByKey(v.Field(i), key); ok {
+				return field, true
+			}
+			continue
+		}
+		if fieldKey == key {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func yamlKey(field reflect.StructField) (string, bool) {
+	name, options, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if strings.Contains(options, "inline") {
+		return "", true
+	}
+	if name == "" {
+		return strings.ToLower(field.Name), false
+	}
+	return name, false
+}
+
+func joinPath(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLayeredLoader_Load(t *testing.T) {
+	readTestData := func(file string) []byte {
+		data, err := os.ReadFile(testDataDir + "/" + file)
+		if err != nil {
+			t.Fatalf("Error reading config data file: %s", file)
+		}
+		return data
+	}
+	defaults := readTestData("app-config-all-filled.yml")
+	credentials := SectionLayer[Settings]("credentials", "credentials", readTestData("cli-credentials-config-all-filled.yml"))
+	tests := []struct {
+		name    string
+		layers  []Layer[Settings]
+		want    Settings
+		wantErr bool
+	}{
+		{
+			name:   "should load the defaults with the credentials section",
+			layers: []Layer[Settings]{credentials},
+			want: Settings{
+				AppConfig:   AppConfig{Client: CliConfig{BaseURL: "http://dummy.url", AccountsURL: "http://dummy.url"}},
+				Credentials: CliCredentials{ID: "DUMMY_CLIENT_ID", Secret: "DUMMY_CLIENT_SECRET"},
+			},
+		},
+		{
+			name: "should let the file override the defaults, the environment the file and the flags the environment",
+			layers: []Layer[Settings]{
+				credentials,
+				FileLayer[Settings](testDataDir + "/user-config-partial.yml"),
+				EnvLayer[Settings](func(key string) (string, bool) {
+					env := map[string]string{
+						"SPOTIFY_BASE_URL":        "http://env.dummy.url",
+						"SPOTIFY_ACCOUNTS_URL":    "http://env.dummy.url",
+						"SPOTIFY_SCOPES":          "playlist-read-private, user-library-read",
+						"SPOTIFY_STRICT_DECODING": "true",
+					}
+					value, ok := env[key]
+					return value, ok
+				}),
+				ValuesLayer[Settings]("flags", map[string]string{"client.base_url": "http://flag.dummy.url"}),
+			},
+			want: Settings{
+				AppConfig: AppConfig{
+					Client: CliConfig{BaseURL: "http://flag.dummy.url", AccountsURL: "http://env.dummy.url", StrictDecoding: true},
+					User: &UserAuthConfig{
+						RedirectURL: "http://127.0.0.1:8888/callback",
+						Scopes:      []string{"playlist-read-private", "user-library-read"},
+					},
+				},
+				Credentials: CliCredentials{ID: "DUMMY_CLIENT_ID", Secret: "USER_CLIENT_SECRET"},
+			},
+		},
+		{
+			name:   "should skip an optional file that doesn't exist",
+			layers: []Layer[Settings]{credentials, OptionalFileLayer[Settings](testDataDir + "/missing.yml")},
+			want: Settings{
+				AppConfig:   AppConfig{Client: CliConfig{BaseURL: "http://dummy.url", AccountsURL: "http://dummy.url"}},
+				Credentials: CliCredentials{ID: "DUMMY_CLIENT_ID", Secret: "DUMMY_CLIENT_SECRET"},
+			},
+		},
+		{
+			name:    "should return error when a required file doesn't exist",
+			layers:  []Layer[Settings]{credentials, FileLayer[Settings](testDataDir + "/missing.yml")},
+			wantErr: true,
+		},
+		{
+			name:    "should return error when a file has unknown fields",
+			layers:  []Layer[Settings]{credentials, FileLayer[Settings](testDataDir + "/user-config-unknown-field.yml")},
+			wantErr: true,
+		},
+		{
+			name: "should return error when an environment variable doesn't parse",
+			layers: []Layer[Settings]{credentials, EnvLayer[Settings](func(key string) (string, bool) {
+				return "maybe", key == "SPOTIFY_STRICT_DECODING"
+			})},
+			wantErr: true,
+		},
+		{
+			name:    "should return error when a flag sets an unknown path",
+			layers:  []Layer[Settings]{credentials, ValuesLayer[Settings]("flags", map[string]string{"client.base_uri": "http://dummy.url"})},
+			wantErr: true,
+		},
+		{
+			name:    "should return error when the merged config misses required fields",
+			layers:  []Layer[Settings]{ValuesLayer[Settings]("flags", map[string]string{"credentials.client_id": "DUMMY_CLIENT_ID"})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLayeredLoader(tt.layers...).Load(defaults)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileLayer(t *testing.T) {
+	defaults, err := os.ReadFile(testDataDir + "/app-config-all-filled.yml")
+	if err != nil {
+		t.Fatalf("Error reading config data file - %v", err)
+	}
+	baseClient := CliConfig{BaseURL: "http://dummy.url", AccountsURL: "http://dummy.url"}
+	tests := []struct {
+		name    string
+		file    string
+		profile string
+		want    Settings
+		wantErr bool
+	}{
+		{
+			name: "should apply the default profile over the file",
+			file: "user-config-profiles.yml",
+			want: Settings{
+				Profile:        "dev",
+				AppConfig:      AppConfig{Client: baseClient},
+				Credentials:    CliCredentials{ID: "DEV_CLIENT_ID", Secret: "DEV_CLIENT_SECRET"},
+				Market:         "BR",
+				DefaultProfile: "dev",
+			},
+		},
+		{
+			name:    "should apply the selected profile, keeping the fields it doesn't set",
+			file:    "user-config-profiles.yml",
+			profile: "mock",
+			want: Settings{
+				Profile:        "mock",
+				AppConfig:      AppConfig{Client: CliConfig{BaseURL: "http://localhost:8080", AccountsURL: "http://localhost:8080", StrictDecoding: true}},
+				Credentials:    CliCredentials{ID: "BASE_CLIENT_ID", Secret: "BASE_CLIENT_SECRET"},
+				HTTP:           HTTPConfig{Timeout: Duration(2 * time.Second), MaxAttempts: 1, RequestsPerSecond: 50, Burst: 10},
+				DefaultProfile: "dev",
+			},
+		},
+		{
+			name:    "should return error when the selected profile doesn't exist",
+			file:    "user-config-profiles.yml",
+			profile: "staging",
+			wantErr: true,
+		},
+		{
+			name:    "should return error when any profile has unknown fields",
+			file:    "user-config-profiles-invalid.yml",
+			profile: "dev",
+			wantErr: true,
+		},
+		{
+			name:    "should return error when the applied profile fails validation",
+			file:    "user-config-profiles-malformed-url.yml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLayeredLoader(
+				ValuesLayer[Settings]("credentials", map[string]string{"credentials.client_id": "ID", "credentials.client_secret": "SECRET"}),
+				FileLayer[Settings](testDataDir+"/"+tt.file),
+				ProfileLayer(tt.profile),
+			)
+
+			got, err := loader.Load(defaults)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got.Profiles = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	settings := Settings{
+		AppConfig:   AppConfig{User: &UserAuthConfig{RedirectURL: "http://127.0.0.1:8888/callback"}},
+		Credentials: CliCredentials{ID: "DUMMY_CLIENT_ID", Secret: "DUMMY_CLIENT_SECRET"},
+	}
+
+	got := Redacted(settings)
+
+	if got.Credentials.Secret != RedactedValue || got.Credentials.ID != "DUMMY_CLIENT_ID" {
+		t.Errorf("Redacted() credentials = %+v, want only the secret redacted", got.Credentials)
+	}
+	if settings.Credentials.Secret != "DUMMY_CLIENT_SECRET" || got.User == settings.User {
+		t.Errorf("Redacted() changed the original config")
+	}
+	if empty := Redacted(CliCredentials{}); empty.Secret != "" {
+		t.Errorf("Redacted() secret = %q, want unset secrets left empty", empty.Secret)
+	}
+}
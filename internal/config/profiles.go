@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// Profile holds the settings of a named profile as written, so applying it only overrides the fields it sets.
+type Profile struct {
+	node yaml.Node
+}
+
+func (p *Profile) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: a profile must be a mapping of settings", node.Line)
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if key := node.Content[i].Value; key == "profiles" || key == "default_profile" {
+			return fmt.Errorf("line %d: profiles can't set %s", node.Content[i].Line, key)
+		}
+	}
+	p.node = *node
+	return nil
+}
+
+func (p Profile) apply(settings *Settings) error {
+	data, err := yaml.Marshal(&p.node)
+	if err != nil {
+		return fmt.Errorf("error reading profile - %w", err)
+	}
+	return decodeStrict(data, settings)
+}
+
+type profileLayer struct {
+	name string
+}
+
+// ProfileLayer applies the profile called name over the settings, or their DefaultProfile when name is empty. Every
+// profile is checked for unknown fields, not only the applied one.
+func ProfileLayer(name string) Layer[Settings] {
+	return profileLayer{name: name}
+}
+
+func (l profileLayer) Name() string {
+	return "profile"
+}
+
+func (l profileLayer) Apply(settings *Settings) error {
+	names := lo.Keys(settings.Profiles)
+	slices.Sort(names)
+	for _, name := range names {
+		if err := settings.Profiles[name].apply(&Settings{}); err != nil {
+			return fmt.Errorf("invalid profile %q - %w", name, err)
+		}
+	}
+	name := l.name
+	if name == "" {
+		name = settings.DefaultProfile
+	}
+	if name == "" {
+		return nil
+	}
+	profile, ok := settings.Profiles[name]
+	if !ok && len(names) == 0 {
+		return fmt.Errorf("unknown profile %q, no profiles are configured", name)
+	}
+	if !ok {
+		return fmt.Errorf("unknown profile %q, expected one of [%s]", name, strings.Join(names, ", "))
+	}
+	if err := profile.apply(settings); err != nil {
+		return fmt.Errorf("invalid profile %q - %w", name, err)
+	}
+	settings.Profile = name
+	return nil
+}
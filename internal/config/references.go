@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+)
+
+// Prefixes of the values of fields tagged with `ref:"true"` that are references to where the value is, instead of the
+// value itself.
+const (
+	FileReferencePrefix = "file:"
+	EnvReferencePrefix  = "env:"
+	ExecReferencePrefix = "exec:"
+)
+
+var (
+	// for testing purposes
+	osLookupEnv = os.LookupEnv
+	// for testing purposes
+	runCommand = func(command string) ([]byte, error) {
+		cmd := exec.Command("sh", "-c", command)
+		// prompts and diagnostics of password managers go straight to the user, never into errors
+		cmd.Stdin, cmd.Stderr = os.Stdin, os.Stderr
+		return cmd.Output()
+	}
+)
+
+// ResolveReference returns the value value refers to: the content of the file of file:/path, the environment
+// variable of env:NAME or the output of the shell command of exec:command, without surrounding spaces. Any other
+// value is returned as is. Errors name the reference, never the value.
+func ResolveReference(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, FileReferencePrefix):
+		path := strings.TrimPrefix(value, FileReferencePrefix)
+		data, err := osReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading reference - %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case strings.HasPrefix(value, EnvReferencePrefix):
+		name := strings.TrimPrefix(value, EnvReferencePrefix)
+		resolved, ok := osLookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return strings.TrimSpace(resolved), nil
+	case strings.HasPrefix(value, ExecReferencePrefix):
+		command := strings.TrimPrefix(value, ExecReferencePrefix)
+		output, err := runCommand(command)
+		if err != nil {
+			return "", fmt.Errorf("error running command %q - %w", command, err)
+		}
+		return strings.TrimSpace(string(output)), nil
+	default:
+		return value, nil
+	}
+}
+
+// ResolveReferences replaces the references in the fields of config tagged with `ref:"true"` by their values, and
+// registers the fields tagged with `secret:"true"` so they are redacted from errors.
+func ResolveReferences[T any](config *T) error {
+	return resolveReferences(reflect.ValueOf(config).Elem(), "")
+}
+
+func resolveReferences(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return resolveReferences(v.Elem(), path)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field, structField := v.Field(i), v.Type().Field(i)
+			if !structField.IsExported() {
+				continue
+			}
+			key, inline := yamlKey(structField)
+			fieldPath := path
+			if !inline {
+				fieldPath = joinPath(path, key)
+			}
+			if field.Kind() != reflect.String {
+				if err := resolveReferences(field, fieldPath); err != nil {
+					return err
+				}
+				continue
+			}
+			if structField.Tag.Get("ref") == "true" {
+				resolved, err := ResolveReference(field.String())
+				if err != nil {
+					return fmt.Errorf("error resolving %s - %w", fieldPath, err)
+				}
+				field.SetString(resolved)
+			}
+			if structField.Tag.Get("secret") == "true" {
+				commons.RegisterSecret(field.String())
+			}
+		}
+	default:
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestResolveReference(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		lookupEnv   func(key string) (string, bool)
+		runCommand  func(command string) ([]byte, error)
+		want        string
+		wantErrText string
+	}{
+		{
+			name:  "should keep values that are not references",
+			value: "DUMMY_CLIENT_SECRET",
+			want:  "DUMMY_CLIENT_SECRET",
+		},
+		{
+			name:  "should read the file of file references",
+			value: "file:" + testDataDir + "/cli-credentials-secret.txt",
+			want:  "DUMMY_FILE_CLIENT_SECRET",
+		},
+		{
+			name:        "should return error when the file of a reference doesn't exist",
+			value:       "file:" + testDataDir + "/missing-secret.txt",
+			wantErrText: "missing-secret.txt",
+		},
+		{
+			name:  "should read the variable of env references",
+			value: "env:DUMMY_SECRET",
+			lookupEnv: func(key string) (string, bool) {
+				return "DUMMY_ENV_CLIENT_SECRET", key == "DUMMY_SECRET"
+			},
+			want: "DUMMY_ENV_CLIENT_SECRET",
+		},
+		{
+			name:  "should return error when the variable of a reference is not set",
+			value: "env:DUMMY_SECRET",
+			lookupEnv: func(string) (string, bool) {
+				return "", false
+			},
+			wantErrText: "environment variable DUMMY_SECRET is not set",
+		},
+		{
+			name:  "should run the command of exec references",
+			value: "exec:pass show spotify/secret",
+			runCommand: func(command string) ([]byte, error) {
+				if command != "pass show spotify/secret" {
+					return nil, errors.New("unexpected command")
+				}
+				return []byte("DUMMY_EXEC_CLIENT_SECRET\n"), nil
+			},
+			want: "DUMMY_EXEC_CLIENT_SECRET",
+		},
+		{
+			name:  "should return error without the output when the command of a reference fails",
+			value: "exec:pass show spotify/secret",
+			runCommand: func(string) ([]byte, error) {
+				return []byte("DUMMY_PARTIAL_CLIENT_SECRET"), errors.New("exit status 1")
+			},
+			wantErrText: `command "pass show spotify/secret" - exit status 1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.lookupEnv != nil {
+				osLookupEnv = tt.lookupEnv
+				defer func() { osLookupEnv = os.LookupEnv }()
+			}
+			if tt.runCommand != nil {
+				original := runCommand
+				runCommand = tt.runCommand
+				defer func() { runCommand = original }()
+			}
+
+			got, err := ResolveReference(tt.value)
+
+			if tt.wantErrText == "" && err != nil {
+				t.Fatalf("ResolveReference() error = %v", err)
+			}
+			if tt.wantErrText != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErrText) || strings.Contains(err.Error(), "CLIENT_SECRET")) {
+				t.Fatalf("ResolveReference() error = %v, want it to contain %q and no value", err, tt.wantErrText)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveReference() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveReference_Exec(t *testing.T) {
+	got, err := ResolveReference("exec:printf 'DUMMY_EXEC_CLIENT_SECRET\\n'")
+	if err != nil || got != "DUMMY_EXEC_CLIENT_SECRET" {
+		t.Errorf("ResolveReference() = %q, %v, want the command output", got, err)
+	}
+}
+
+func TestResolveReferences_RegistersSecrets(t *testing.T) {
+	credentials := CliCredentials{ID: "DUMMY_CLIENT_ID", Secret: "env:DUMMY_SPOTIFY_CLIENT_SECRET"}
+	t.Setenv("DUMMY_SPOTIFY_CLIENT_SECRET", "DUMMY_REFERENCED_CLIENT_SECRET")
+
+	if err := ResolveReferences(&credentials); err != nil {
+		t.Fatalf("ResolveReferences() error = %v", err)
+	}
+
+	if credentials.Secret != "DUMMY_REFERENCED_CLIENT_SECRET" {
+		t.Errorf("ResolveReferences() secret = %q, want it resolved", credentials.Secret)
+	}
+	redacted := commons.Redact("client_id=DUMMY_CLIENT_ID&client_secret=DUMMY_REFERENCED_CLIENT_SECRET")
+	if strings.Contains(redacted, "REFERENCED") || !strings.HasPrefix(redacted, "client_id=DUMMY_CLIENT_ID&") {
+		t.Errorf("Redact() = %q, want only the secret redacted", redacted)
+	}
+}
+
+func TestLayeredLoader_Load_WithoutReferences(t *testing.T) {
+	original := runCommand
+	defer func() { runCommand = original }()
+	runCommand = func(command string) ([]byte, error) {
+		t.Errorf("runCommand(%q) called, want references left unresolved", command)
+		return nil, errors.New("unexpected command")
+	}
+	loader := NewLayeredLoader(
+		ValuesLayer[CliCredentials]("credentials", map[string]string{"client_id": "DUMMY_CLIENT_ID", "client_secret": "exec:pass show spotify/secret"}),
+	).WithoutReferences()
+
+	got, err := loader.Load(nil)
+
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got.Secret != "exec:pass show spotify/secret" {
+		t.Errorf("Load() secret = %q, want the reference as is", got.Secret)
+	}
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"reflect"
+	"time"
+)
+
+// RedactedValue replaces the secrets shown by Redacted.
+const RedactedValue = commons.RedactedValue
+
+// Settings is the whole config of the CLI, as merged by a LayeredLoader.
+type Settings struct {
+	// Profile is the name of the profile applied by a ProfileLayer, if any
+	Profile     string `json:"profile,omitempty" yaml:"-"`
+	AppConfig   `yaml:",inline"`
+	Credentials CliCredentials `json:"credentials" yaml:"credentials"`
+	// Market is used by the commands taking a market when none is given
+	Market string     `json:"market,omitempty" yaml:"market,omitempty" env:"SPOTIFY_MARKET"`
+	HTTP   HTTPConfig `json:"http" yaml:"http"`
+	// DefaultProfile is applied when no profile is selected
+	DefaultProfile string             `json:"default_profile,omitempty" yaml:"default_profile,omitempty"`
+	Profiles       map[string]Profile `json:"-" yaml:"profiles,omitempty"`
+}
+
+// HTTPConfig tunes the HTTP client. Zero values keep the client defaults.
+type HTTPConfig struct {
+	Timeout           Duration `json:"timeout,omitempty" yaml:"timeout,omitempty" validate:"gte=0"`
+	MaxAttempts       int      `json:"max_attempts,omitempty" yaml:"max_attempts,omitempty" validate:"gte=0"`
+	RequestsPerSecond float64  `json:"requests_per_second,omitempty" yaml:"requests_per_second,omitempty" validate:"gte=0"`
+	Burst             int      `json:"burst,omitempty" yaml:"burst,omitempty" validate:"gte=0"`
+}
+
+// Duration is a time.Duration written as text, such as "10s" or "1m30s".
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	duration, err := time.ParseDuration(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid duration %q, expected e.g. 10s or 1m30s", text)
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// Redacted returns a copy of config with the fields tagged with `secret:"true"` that are set replaced by
+// RedactedValue, so it can be shown.
+func Redacted[T any](config T) T {
+	v := reflect.ValueOf(&config).Elem()
+	redact(v)
+	return config
+}
+
+func redact(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		// the copy shares the pointed value with the original, which must be left untouched
+		elem := reflect.New(v.Type().Elem())
+		elem.Elem().Set(v.Elem())
+		v.Set(elem)
+		redact(elem.Elem())
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Field(i)
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if v.Type().Field(i).Tag.Get("secret") == "true" && field.Kind() == reflect.String {
+				if field.String() != "" {
+					field.SetString(RedactedValue)
+				}
+				continue
+			}
+			redact(field)
+		}
+	default:
+	}
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	model "jezz-go-spotify-integration/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type AuthenticationFlow struct {
 	mock.Mock
 }
 
-// Authenticate provides a mock function with no fields
-func (_m *AuthenticationFlow) Authenticate() (*model.Authentication, error) {
-	ret := _m.Called()
+// Authenticate provides a mock function with given fields: ctx
+func (_m *AuthenticationFlow) Authenticate(ctx context.Context) (*model.Authentication, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Authenticate")
@@ -23,19 +24,19 @@ func (_m *AuthenticationFlow) Authenticate() (*model.Authentication, error) {
 
 	var r0 *model.Authentication
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*model.Authentication, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.Authentication, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *model.Authentication); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *model.Authentication); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Authentication)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// OpenAuthorizeURLFn is an autogenerated mock type for the OpenAuthorizeURLFn type
+type OpenAuthorizeURLFn struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: authorizeURL
+func (_m *OpenAuthorizeURLFn) Execute(authorizeURL string) error {
+	ret := _m.Called(authorizeURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(authorizeURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewOpenAuthorizeURLFn creates a new instance of OpenAuthorizeURLFn. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOpenAuthorizeURLFn(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OpenAuthorizeURLFn {
+	mock := &OpenAuthorizeURLFn{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,89 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RefreshableAuthenticationFlow is an autogenerated mock type for the RefreshableAuthenticationFlow type
+type RefreshableAuthenticationFlow struct {
+	mock.Mock
+}
+
+// Authenticate provides a mock function with given fields: ctx
+func (_m *RefreshableAuthenticationFlow) Authenticate(ctx context.Context) (*model.Authentication, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *model.Authentication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.Authentication, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.Authentication); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Authentication)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *RefreshableAuthenticationFlow) Refresh(ctx context.Context, refreshToken model.RefreshToken) (*model.Authentication, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *model.Authentication
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshToken) (*model.Authentication, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshToken) *model.Authentication); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Authentication)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefreshToken) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRefreshableAuthenticationFlow creates a new instance of RefreshableAuthenticationFlow. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRefreshableAuthenticationFlow(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RefreshableAuthenticationFlow {
+	mock := &RefreshableAuthenticationFlow{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	model "jezz-go-spotify-integration/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,17 +14,17 @@ type HTTPApiClient struct {
 	mock.Mock
 }
 
-// DoRequest provides a mock function with given fields: method, url, queryParams, contentType, accessToken, responseTypedOutput
-func (_m *HTTPApiClient) DoRequest(method model.HTTPMethod, url string, queryParams *model.QueryParams, contentType string, accessToken *model.AccessToken, responseTypedOutput interface{}) error {
-	ret := _m.Called(method, url, queryParams, contentType, accessToken, responseTypedOutput)
+// DoRequest provides a mock function with given fields: ctx, method, url, queryParams, contentType, accessToken, requestBody, responseTypedOutput
+func (_m *HTTPApiClient) DoRequest(ctx context.Context, method model.HTTPMethod, url string, queryParams *model.QueryParams, contentType string, accessToken *model.AccessToken, requestBody interface{}, responseTypedOutput interface{}) error {
+	ret := _m.Called(ctx, method, url, queryParams, contentType, accessToken, requestBody, responseTypedOutput)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DoRequest")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(model.HTTPMethod, string, *model.QueryParams, string, *model.AccessToken, interface{}) error); ok {
-		r0 = rf(method, url, queryParams, contentType, accessToken, responseTypedOutput)
+	if rf, ok := ret.Get(0).(func(context.Context, model.HTTPMethod, string, *model.QueryParams, string, *model.AccessToken, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, method, url, queryParams, contentType, accessToken, requestBody, responseTypedOutput)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ItemRenderer is an autogenerated mock type for the ItemRenderer type
+type ItemRenderer struct {
+	mock.Mock
+}
+
+// RenderItem provides a mock function with given fields: w, item
+func (_m *ItemRenderer) RenderItem(w io.Writer, item interface{}) error {
+	ret := _m.Called(w, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer, interface{}) error); ok {
+		r0 = rf(w, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewItemRenderer creates a new instance of ItemRenderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewItemRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ItemRenderer {
+	mock := &ItemRenderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	model "jezz-go-spotify-integration/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type AlbumsResource struct {
 	mock.Mock
 }
 
-// GetAlbum provides a mock function with given fields: accessToken, market, albumID
-func (_m *AlbumsResource) GetAlbum(accessToken model.AccessToken, market *model.AvailableMarket, albumID model.ID) (model.Album, error) {
-	ret := _m.Called(accessToken, market, albumID)
+// GetAlbum provides a mock function with given fields: ctx, accessToken, market, albumID
+func (_m *AlbumsResource) GetAlbum(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, albumID model.ID) (model.Album, error) {
+	ret := _m.Called(ctx, accessToken, market, albumID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAlbum")
@@ -23,17 +24,17 @@ func (_m *AlbumsResource) GetAlbum(accessToken model.AccessToken, market *model.
 
 	var r0 model.Album
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.ID) (model.Album, error)); ok {
-		return rf(accessToken, market, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) (model.Album, error)); ok {
+		return rf(ctx, accessToken, market, albumID)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.ID) model.Album); ok {
-		r0 = rf(accessToken, market, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) model.Album); ok {
+		r0 = rf(ctx, accessToken, market, albumID)
 	} else {
 		r0 = ret.Get(0).(model.Album)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
-		r1 = rf(accessToken, market, albumID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, albumID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +42,9 @@ func (_m *AlbumsResource) GetAlbum(accessToken model.AccessToken, market *model.
 	return r0, r1
 }
 
-// GetAlbumTracks provides a mock function with given fields: accessToken, market, limit, offset, albumID
-func (_m *AlbumsResource) GetAlbumTracks(accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, albumID model.ID) (model.SimplifiedTracksPaginated, error) {
-	ret := _m.Called(accessToken, market, limit, offset, albumID)
+// GetAlbumTracks provides a mock function with given fields: ctx, accessToken, market, limit, offset, albumID
+func (_m *AlbumsResource) GetAlbumTracks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, albumID model.ID) (model.SimplifiedTracksPaginated, error) {
+	ret := _m.Called(ctx, accessToken, market, limit, offset, albumID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAlbumTracks")
@@ -51,17 +52,17 @@ func (_m *AlbumsResource) GetAlbumTracks(accessToken model.AccessToken, market *
 
 	var r0 model.SimplifiedTracksPaginated
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) (model.SimplifiedTracksPaginated, error)); ok {
-		return rf(accessToken, market, limit, offset, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) (model.SimplifiedTracksPaginated, error)); ok {
+		return rf(ctx, accessToken, market, limit, offset, albumID)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) model.SimplifiedTracksPaginated); ok {
-		r0 = rf(accessToken, market, limit, offset, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) model.SimplifiedTracksPaginated); ok {
+		r0 = rf(ctx, accessToken, market, limit, offset, albumID)
 	} else {
 		r0 = ret.Get(0).(model.SimplifiedTracksPaginated)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) error); ok {
-		r1 = rf(accessToken, market, limit, offset, albumID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, limit, offset, albumID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,9 +70,9 @@ func (_m *AlbumsResource) GetAlbumTracks(accessToken model.AccessToken, market *
 	return r0, r1
 }
 
-// GetAlbums provides a mock function with given fields: accessToken, market, albumsIDs
-func (_m *AlbumsResource) GetAlbums(accessToken model.AccessToken, market *model.AvailableMarket, albumsIDs model.AlbumsIDs) ([]model.Album, error) {
-	ret := _m.Called(accessToken, market, albumsIDs)
+// GetAlbums provides a mock function with given fields: ctx, accessToken, market, albumsIDs
+func (_m *AlbumsResource) GetAlbums(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, albumsIDs model.AlbumsIDs) ([]model.Album, error) {
+	ret := _m.Called(ctx, accessToken, market, albumsIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAlbums")
@@ -79,19 +80,19 @@ func (_m *AlbumsResource) GetAlbums(accessToken model.AccessToken, market *model
 
 	var r0 []model.Album
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.AlbumsIDs) ([]model.Album, error)); ok {
-		return rf(accessToken, market, albumsIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.AlbumsIDs) ([]model.Album, error)); ok {
+		return rf(ctx, accessToken, market, albumsIDs)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.AlbumsIDs) []model.Album); ok {
-		r0 = rf(accessToken, market, albumsIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.AlbumsIDs) []model.Album); ok {
+		r0 = rf(ctx, accessToken, market, albumsIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Album)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AvailableMarket, model.AlbumsIDs) error); ok {
-		r1 = rf(accessToken, market, albumsIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.AlbumsIDs) error); ok {
+		r1 = rf(ctx, accessToken, market, albumsIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,9 +100,9 @@ func (_m *AlbumsResource) GetAlbums(accessToken model.AccessToken, market *model
 	return r0, r1
 }
 
-// GetNewReleases provides a mock function with given fields: accessToken, limit, offset
-func (_m *AlbumsResource) GetNewReleases(accessToken model.AccessToken, limit *model.Limit, offset *model.Offset) (model.AlbumsNewRelease, error) {
-	ret := _m.Called(accessToken, limit, offset)
+// GetNewReleases provides a mock function with given fields: ctx, accessToken, limit, offset
+func (_m *AlbumsResource) GetNewReleases(ctx context.Context, accessToken model.AccessToken, limit *model.Limit, offset *model.Offset) (model.AlbumsNewRelease, error) {
+	ret := _m.Called(ctx, accessToken, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNewReleases")
@@ -109,17 +110,17 @@ func (_m *AlbumsResource) GetNewReleases(accessToken model.AccessToken, limit *m
 
 	var r0 model.AlbumsNewRelease
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.Limit, *model.Offset) (model.AlbumsNewRelease, error)); ok {
-		return rf(accessToken, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.Limit, *model.Offset) (model.AlbumsNewRelease, error)); ok {
+		return rf(ctx, accessToken, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.Limit, *model.Offset) model.AlbumsNewRelease); ok {
-		r0 = rf(accessToken, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.Limit, *model.Offset) model.AlbumsNewRelease); ok {
+		r0 = rf(ctx, accessToken, limit, offset)
 	} else {
 		r0 = ret.Get(0).(model.AlbumsNewRelease)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.Limit, *model.Offset) error); ok {
-		r1 = rf(accessToken, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.Limit, *model.Offset) error); ok {
+		r1 = rf(ctx, accessToken, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
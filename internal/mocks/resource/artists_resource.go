@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	model "jezz-go-spotify-integration/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type ArtistsResource struct {
 	mock.Mock
 }
 
-// GetArtist provides a mock function with given fields: accessToken, artistID
-func (_m *ArtistsResource) GetArtist(accessToken model.AccessToken, artistID model.ID) (model.Artist, error) {
-	ret := _m.Called(accessToken, artistID)
+// GetArtist provides a mock function with given fields: ctx, accessToken, artistID
+func (_m *ArtistsResource) GetArtist(ctx context.Context, accessToken model.AccessToken, artistID model.ID) (model.Artist, error) {
+	ret := _m.Called(ctx, accessToken, artistID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtist")
@@ -23,17 +24,17 @@ func (_m *ArtistsResource) GetArtist(accessToken model.AccessToken, artistID mod
 
 	var r0 model.Artist
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, model.ID) (model.Artist, error)); ok {
-		return rf(accessToken, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID) (model.Artist, error)); ok {
+		return rf(ctx, accessToken, artistID)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, model.ID) model.Artist); ok {
-		r0 = rf(accessToken, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID) model.Artist); ok {
+		r0 = rf(ctx, accessToken, artistID)
 	} else {
 		r0 = ret.Get(0).(model.Artist)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, model.ID) error); ok {
-		r1 = rf(accessToken, artistID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, artistID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +42,9 @@ func (_m *ArtistsResource) GetArtist(accessToken model.AccessToken, artistID mod
 	return r0, r1
 }
 
-// GetArtistAlbums provides a mock function with given fields: accessToken, includeGroups, market, limit, offset, artistID
-func (_m *ArtistsResource) GetArtistAlbums(accessToken model.AccessToken, includeGroups *model.AlbumGroups, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, artistID model.ID) (model.SimplifiedArtistAlbumsPaginated, error) {
-	ret := _m.Called(accessToken, includeGroups, market, limit, offset, artistID)
+// GetArtistAlbums provides a mock function with given fields: ctx, accessToken, includeGroups, market, limit, offset, artistID
+func (_m *ArtistsResource) GetArtistAlbums(ctx context.Context, accessToken model.AccessToken, includeGroups *model.AlbumGroups, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, artistID model.ID) (model.SimplifiedArtistAlbumsPaginated, error) {
+	ret := _m.Called(ctx, accessToken, includeGroups, market, limit, offset, artistID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtistAlbums")
@@ -51,17 +52,17 @@ func (_m *ArtistsResource) GetArtistAlbums(accessToken model.AccessToken, includ
 
 	var r0 model.SimplifiedArtistAlbumsPaginated
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AlbumGroups, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) (model.SimplifiedArtistAlbumsPaginated, error)); ok {
-		return rf(accessToken, includeGroups, market, limit, offset, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AlbumGroups, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) (model.SimplifiedArtistAlbumsPaginated, error)); ok {
+		return rf(ctx, accessToken, includeGroups, market, limit, offset, artistID)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AlbumGroups, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) model.SimplifiedArtistAlbumsPaginated); ok {
-		r0 = rf(accessToken, includeGroups, market, limit, offset, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AlbumGroups, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) model.SimplifiedArtistAlbumsPaginated); ok {
+		r0 = rf(ctx, accessToken, includeGroups, market, limit, offset, artistID)
 	} else {
 		r0 = ret.Get(0).(model.SimplifiedArtistAlbumsPaginated)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AlbumGroups, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) error); ok {
-		r1 = rf(accessToken, includeGroups, market, limit, offset, artistID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AlbumGroups, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, includeGroups, market, limit, offset, artistID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,9 +70,9 @@ func (_m *ArtistsResource) GetArtistAlbums(accessToken model.AccessToken, includ
 	return r0, r1
 }
 
-// GetArtistTopTracks provides a mock function with given fields: accessToken, market, artistID
-func (_m *ArtistsResource) GetArtistTopTracks(accessToken model.AccessToken, market *model.AvailableMarket, artistID model.ID) ([]model.Track, error) {
-	ret := _m.Called(accessToken, market, artistID)
+// GetArtistTopTracks provides a mock function with given fields: ctx, accessToken, market, artistID
+func (_m *ArtistsResource) GetArtistTopTracks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, artistID model.ID) ([]model.Track, error) {
+	ret := _m.Called(ctx, accessToken, market, artistID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtistTopTracks")
@@ -79,19 +80,19 @@ func (_m *ArtistsResource) GetArtistTopTracks(accessToken model.AccessToken, mar
 
 	var r0 []model.Track
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.ID) ([]model.Track, error)); ok {
-		return rf(accessToken, market, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) ([]model.Track, error)); ok {
+		return rf(ctx, accessToken, market, artistID)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.ID) []model.Track); ok {
-		r0 = rf(accessToken, market, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) []model.Track); ok {
+		r0 = rf(ctx, accessToken, market, artistID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Track)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
-		r1 = rf(accessToken, market, artistID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, artistID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,9 +100,9 @@ func (_m *ArtistsResource) GetArtistTopTracks(accessToken model.AccessToken, mar
 	return r0, r1
 }
 
-// GetArtists provides a mock function with given fields: accessToken, artistsIDs
-func (_m *ArtistsResource) GetArtists(accessToken model.AccessToken, artistsIDs model.ArtistsIDs) ([]model.Artist, error) {
-	ret := _m.Called(accessToken, artistsIDs)
+// GetArtists provides a mock function with given fields: ctx, accessToken, artistsIDs
+func (_m *ArtistsResource) GetArtists(ctx context.Context, accessToken model.AccessToken, artistsIDs model.ArtistsIDs) ([]model.Artist, error) {
+	ret := _m.Called(ctx, accessToken, artistsIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtists")
@@ -109,19 +110,19 @@ func (_m *ArtistsResource) GetArtists(accessToken model.AccessToken, artistsIDs
 
 	var r0 []model.Artist
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, model.ArtistsIDs) ([]model.Artist, error)); ok {
-		return rf(accessToken, artistsIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ArtistsIDs) ([]model.Artist, error)); ok {
+		return rf(ctx, accessToken, artistsIDs)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, model.ArtistsIDs) []model.Artist); ok {
-		r0 = rf(accessToken, artistsIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ArtistsIDs) []model.Artist); ok {
+		r0 = rf(ctx, accessToken, artistsIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Artist)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, model.ArtistsIDs) error); ok {
-		r1 = rf(accessToken, artistsIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ArtistsIDs) error); ok {
+		r1 = rf(ctx, accessToken, artistsIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,115 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AudiobooksResource is an autogenerated mock type for the AudiobooksResource type
+type AudiobooksResource struct {
+	mock.Mock
+}
+
+// GetAudiobook provides a mock function with given fields: ctx, accessToken, market, audiobookID
+func (_m *AudiobooksResource) GetAudiobook(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, audiobookID model.ID) (model.Audiobook, error) {
+	ret := _m.Called(ctx, accessToken, market, audiobookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudiobook")
+	}
+
+	var r0 model.Audiobook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) (model.Audiobook, error)); ok {
+		return rf(ctx, accessToken, market, audiobookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) model.Audiobook); ok {
+		r0 = rf(ctx, accessToken, market, audiobookID)
+	} else {
+		r0 = ret.Get(0).(model.Audiobook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, audiobookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAudiobookChapters provides a mock function with given fields: ctx, accessToken, market, limit, offset, audiobookID
+func (_m *AudiobooksResource) GetAudiobookChapters(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, audiobookID model.ID) (model.SimplifiedChaptersPaginated, error) {
+	ret := _m.Called(ctx, accessToken, market, limit, offset, audiobookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudiobookChapters")
+	}
+
+	var r0 model.SimplifiedChaptersPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) (model.SimplifiedChaptersPaginated, error)); ok {
+		return rf(ctx, accessToken, market, limit, offset, audiobookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) model.SimplifiedChaptersPaginated); ok {
+		r0 = rf(ctx, accessToken, market, limit, offset, audiobookID)
+	} else {
+		r0 = ret.Get(0).(model.SimplifiedChaptersPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, limit, offset, audiobookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAudiobooks provides a mock function with given fields: ctx, accessToken, market, audiobooksIDs
+func (_m *AudiobooksResource) GetAudiobooks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, audiobooksIDs model.AudiobooksIDs) ([]model.Audiobook, error) {
+	ret := _m.Called(ctx, accessToken, market, audiobooksIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudiobooks")
+	}
+
+	var r0 []model.Audiobook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.AudiobooksIDs) ([]model.Audiobook, error)); ok {
+		return rf(ctx, accessToken, market, audiobooksIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.AudiobooksIDs) []model.Audiobook); ok {
+		r0 = rf(ctx, accessToken, market, audiobooksIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Audiobook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.AudiobooksIDs) error); ok {
+		r1 = rf(ctx, accessToken, market, audiobooksIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAudiobooksResource creates a new instance of AudiobooksResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAudiobooksResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AudiobooksResource {
+	mock := &AudiobooksResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BrowseResource is an autogenerated mock type for the BrowseResource type
+type BrowseResource struct {
+	mock.Mock
+}
+
+// GetCategories provides a mock function with given fields: ctx, accessToken, locale, limit, offset
+func (_m *BrowseResource) GetCategories(ctx context.Context, accessToken model.AccessToken, locale *model.Locale, limit *model.Limit, offset *model.Offset) (model.CategoriesPaginated, error) {
+	ret := _m.Called(ctx, accessToken, locale, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 model.CategoriesPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.Locale, *model.Limit, *model.Offset) (model.CategoriesPaginated, error)); ok {
+		return rf(ctx, accessToken, locale, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.Locale, *model.Limit, *model.Offset) model.CategoriesPaginated); ok {
+		r0 = rf(ctx, accessToken, locale, limit, offset)
+	} else {
+		r0 = ret.Get(0).(model.CategoriesPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.Locale, *model.Limit, *model.Offset) error); ok {
+		r1 = rf(ctx, accessToken, locale, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategory provides a mock function with given fields: ctx, accessToken, locale, categoryID
+func (_m *BrowseResource) GetCategory(ctx context.Context, accessToken model.AccessToken, locale *model.Locale, categoryID model.ID) (model.Category, error) {
+	ret := _m.Called(ctx, accessToken, locale, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.Locale, model.ID) (model.Category, error)); ok {
+		return rf(ctx, accessToken, locale, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.Locale, model.ID) model.Category); ok {
+		r0 = rf(ctx, accessToken, locale, categoryID)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.Locale, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, locale, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBrowseResource creates a new instance of BrowseResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBrowseResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BrowseResource {
+	mock := &BrowseResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,87 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChaptersResource is an autogenerated mock type for the ChaptersResource type
+type ChaptersResource struct {
+	mock.Mock
+}
+
+// GetChapter provides a mock function with given fields: ctx, accessToken, market, chapterID
+func (_m *ChaptersResource) GetChapter(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, chapterID model.ID) (model.Chapter, error) {
+	ret := _m.Called(ctx, accessToken, market, chapterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChapter")
+	}
+
+	var r0 model.Chapter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) (model.Chapter, error)); ok {
+		return rf(ctx, accessToken, market, chapterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) model.Chapter); ok {
+		r0 = rf(ctx, accessToken, market, chapterID)
+	} else {
+		r0 = ret.Get(0).(model.Chapter)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, chapterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChapters provides a mock function with given fields: ctx, accessToken, market, chaptersIDs
+func (_m *ChaptersResource) GetChapters(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, chaptersIDs model.ChaptersIDs) ([]model.Chapter, error) {
+	ret := _m.Called(ctx, accessToken, market, chaptersIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChapters")
+	}
+
+	var r0 []model.Chapter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ChaptersIDs) ([]model.Chapter, error)); ok {
+		return rf(ctx, accessToken, market, chaptersIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ChaptersIDs) []model.Chapter); ok {
+		r0 = rf(ctx, accessToken, market, chaptersIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Chapter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ChaptersIDs) error); ok {
+		r1 = rf(ctx, accessToken, market, chaptersIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewChaptersResource creates a new instance of ChaptersResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChaptersResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChaptersResource {
+	mock := &ChaptersResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
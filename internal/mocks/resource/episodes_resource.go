@@ -0,0 +1,87 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EpisodesResource is an autogenerated mock type for the EpisodesResource type
+type EpisodesResource struct {
+	mock.Mock
+}
+
+// GetEpisode provides a mock function with given fields: ctx, accessToken, market, episodeID
+func (_m *EpisodesResource) GetEpisode(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, episodeID model.ID) (model.Episode, error) {
+	ret := _m.Called(ctx, accessToken, market, episodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpisode")
+	}
+
+	var r0 model.Episode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) (model.Episode, error)); ok {
+		return rf(ctx, accessToken, market, episodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) model.Episode); ok {
+		r0 = rf(ctx, accessToken, market, episodeID)
+	} else {
+		r0 = ret.Get(0).(model.Episode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, episodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEpisodes provides a mock function with given fields: ctx, accessToken, market, episodesIDs
+func (_m *EpisodesResource) GetEpisodes(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, episodesIDs model.EpisodesIDs) ([]model.Episode, error) {
+	ret := _m.Called(ctx, accessToken, market, episodesIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpisodes")
+	}
+
+	var r0 []model.Episode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.EpisodesIDs) ([]model.Episode, error)); ok {
+		return rf(ctx, accessToken, market, episodesIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.EpisodesIDs) []model.Episode); ok {
+		r0 = rf(ctx, accessToken, market, episodesIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Episode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.EpisodesIDs) error); ok {
+		r1 = rf(ctx, accessToken, market, episodesIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEpisodesResource creates a new instance of EpisodesResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEpisodesResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EpisodesResource {
+	mock := &EpisodesResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
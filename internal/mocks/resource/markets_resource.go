@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarketsResource is an autogenerated mock type for the MarketsResource type
+type MarketsResource struct {
+	mock.Mock
+}
+
+// GetAvailableMarkets provides a mock function with given fields: ctx, accessToken
+func (_m *MarketsResource) GetAvailableMarkets(ctx context.Context, accessToken model.AccessToken) ([]model.AvailableMarket, error) {
+	ret := _m.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableMarkets")
+	}
+
+	var r0 []model.AvailableMarket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken) ([]model.AvailableMarket, error)); ok {
+		return rf(ctx, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken) []model.AvailableMarket); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AvailableMarket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken) error); ok {
+		r1 = rf(ctx, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMarketsResource creates a new instance of MarketsResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarketsResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarketsResource {
+	mock := &MarketsResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,273 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PlaylistsResource is an autogenerated mock type for the PlaylistsResource type
+type PlaylistsResource struct {
+	mock.Mock
+}
+
+// AddPlaylistItems provides a mock function with given fields: ctx, accessToken, playlistID, request
+func (_m *PlaylistsResource) AddPlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.AddPlaylistItemsRequest) (model.SnapshotID, error) {
+	ret := _m.Called(ctx, accessToken, playlistID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.AddPlaylistItemsRequest) (model.SnapshotID, error)); ok {
+		return rf(ctx, accessToken, playlistID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.AddPlaylistItemsRequest) model.SnapshotID); ok {
+		r0 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID, model.AddPlaylistItemsRequest) error); ok {
+		r1 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangePlaylistDetails provides a mock function with given fields: ctx, accessToken, playlistID, details
+func (_m *PlaylistsResource) ChangePlaylistDetails(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, details model.PlaylistDetails) error {
+	ret := _m.Called(ctx, accessToken, playlistID, details)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePlaylistDetails")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.PlaylistDetails) error); ok {
+		r0 = rf(ctx, accessToken, playlistID, details)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePlaylist provides a mock function with given fields: ctx, accessToken, userID, details
+func (_m *PlaylistsResource) CreatePlaylist(ctx context.Context, accessToken model.AccessToken, userID model.ID, details model.PlaylistDetails) (model.Playlist, error) {
+	ret := _m.Called(ctx, accessToken, userID, details)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.PlaylistDetails) (model.Playlist, error)); ok {
+		return rf(ctx, accessToken, userID, details)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.PlaylistDetails) model.Playlist); ok {
+		r0 = rf(ctx, accessToken, userID, details)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID, model.PlaylistDetails) error); ok {
+		r1 = rf(ctx, accessToken, userID, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylist provides a mock function with given fields: ctx, accessToken, market, fields, additionalTypes, playlistID
+func (_m *PlaylistsResource) GetPlaylist(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, fields *model.Fields, additionalTypes *model.AdditionalTypes, playlistID model.ID) (model.Playlist, error) {
+	ret := _m.Called(ctx, accessToken, market, fields, additionalTypes, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Fields, *model.AdditionalTypes, model.ID) (model.Playlist, error)); ok {
+		return rf(ctx, accessToken, market, fields, additionalTypes, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Fields, *model.AdditionalTypes, model.ID) model.Playlist); ok {
+		r0 = rf(ctx, accessToken, market, fields, additionalTypes, playlistID)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Fields, *model.AdditionalTypes, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, fields, additionalTypes, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylistCoverImage provides a mock function with given fields: ctx, accessToken, playlistID
+func (_m *PlaylistsResource) GetPlaylistCoverImage(ctx context.Context, accessToken model.AccessToken, playlistID model.ID) ([]model.Image, error) {
+	ret := _m.Called(ctx, accessToken, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylistCoverImage")
+	}
+
+	var r0 []model.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID) ([]model.Image, error)); ok {
+		return rf(ctx, accessToken, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID) []model.Image); ok {
+		r0 = rf(ctx, accessToken, playlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylistItems provides a mock function with given fields: ctx, accessToken, market, fields, additionalTypes, limit, offset, playlistID
+func (_m *PlaylistsResource) GetPlaylistItems(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, fields *model.Fields, additionalTypes *model.AdditionalTypes, limit *model.Limit, offset *model.Offset, playlistID model.ID) (model.PlaylistItemsPaginated, error) {
+	ret := _m.Called(ctx, accessToken, market, fields, additionalTypes, limit, offset, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylistItems")
+	}
+
+	var r0 model.PlaylistItemsPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Fields, *model.AdditionalTypes, *model.Limit, *model.Offset, model.ID) (model.PlaylistItemsPaginated, error)); ok {
+		return rf(ctx, accessToken, market, fields, additionalTypes, limit, offset, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Fields, *model.AdditionalTypes, *model.Limit, *model.Offset, model.ID) model.PlaylistItemsPaginated); ok {
+		r0 = rf(ctx, accessToken, market, fields, additionalTypes, limit, offset, playlistID)
+	} else {
+		r0 = ret.Get(0).(model.PlaylistItemsPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Fields, *model.AdditionalTypes, *model.Limit, *model.Offset, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, fields, additionalTypes, limit, offset, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemovePlaylistItems provides a mock function with given fields: ctx, accessToken, playlistID, request
+func (_m *PlaylistsResource) RemovePlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.RemovePlaylistItemsRequest) (model.SnapshotID, error) {
+	ret := _m.Called(ctx, accessToken, playlistID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.RemovePlaylistItemsRequest) (model.SnapshotID, error)); ok {
+		return rf(ctx, accessToken, playlistID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.RemovePlaylistItemsRequest) model.SnapshotID); ok {
+		r0 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID, model.RemovePlaylistItemsRequest) error); ok {
+		r1 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReorderPlaylistItems provides a mock function with given fields: ctx, accessToken, playlistID, request
+func (_m *PlaylistsResource) ReorderPlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.ReorderPlaylistItemsRequest) (model.SnapshotID, error) {
+	ret := _m.Called(ctx, accessToken, playlistID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderPlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.ReorderPlaylistItemsRequest) (model.SnapshotID, error)); ok {
+		return rf(ctx, accessToken, playlistID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.ReorderPlaylistItemsRequest) model.SnapshotID); ok {
+		r0 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID, model.ReorderPlaylistItemsRequest) error); ok {
+		r1 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplacePlaylistItems provides a mock function with given fields: ctx, accessToken, playlistID, request
+func (_m *PlaylistsResource) ReplacePlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.ReplacePlaylistItemsRequest) (model.SnapshotID, error) {
+	ret := _m.Called(ctx, accessToken, playlistID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.ReplacePlaylistItemsRequest) (model.SnapshotID, error)); ok {
+		return rf(ctx, accessToken, playlistID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.ID, model.ReplacePlaylistItemsRequest) model.SnapshotID); ok {
+		r0 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.ID, model.ReplacePlaylistItemsRequest) error); ok {
+		r1 = rf(ctx, accessToken, playlistID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPlaylistsResource creates a new instance of PlaylistsResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlaylistsResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlaylistsResource {
+	mock := &PlaylistsResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
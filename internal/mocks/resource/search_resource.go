@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchResource is an autogenerated mock type for the SearchResource type
+type SearchResource struct {
+	mock.Mock
+}
+
+// Search provides a mock function with given fields: ctx, accessToken, query, searchTypes, market, limit, offset, includeExternal
+func (_m *SearchResource) Search(ctx context.Context, accessToken model.AccessToken, query model.SearchQuery, searchTypes model.SearchTypes, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, includeExternal *model.IncludeExternal) (model.SearchResult, error) {
+	ret := _m.Called(ctx, accessToken, query, searchTypes, market, limit, offset, includeExternal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 model.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.SearchQuery, model.SearchTypes, *model.AvailableMarket, *model.Limit, *model.Offset, *model.IncludeExternal) (model.SearchResult, error)); ok {
+		return rf(ctx, accessToken, query, searchTypes, market, limit, offset, includeExternal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, model.SearchQuery, model.SearchTypes, *model.AvailableMarket, *model.Limit, *model.Offset, *model.IncludeExternal) model.SearchResult); ok {
+		r0 = rf(ctx, accessToken, query, searchTypes, market, limit, offset, includeExternal)
+	} else {
+		r0 = ret.Get(0).(model.SearchResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, model.SearchQuery, model.SearchTypes, *model.AvailableMarket, *model.Limit, *model.Offset, *model.IncludeExternal) error); ok {
+		r1 = rf(ctx, accessToken, query, searchTypes, market, limit, offset, includeExternal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSearchResource creates a new instance of SearchResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchResource {
+	mock := &SearchResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
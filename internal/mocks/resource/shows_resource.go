@@ -0,0 +1,115 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShowsResource is an autogenerated mock type for the ShowsResource type
+type ShowsResource struct {
+	mock.Mock
+}
+
+// GetShow provides a mock function with given fields: ctx, accessToken, market, showID
+func (_m *ShowsResource) GetShow(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, showID model.ID) (model.Show, error) {
+	ret := _m.Called(ctx, accessToken, market, showID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShow")
+	}
+
+	var r0 model.Show
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) (model.Show, error)); ok {
+		return rf(ctx, accessToken, market, showID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) model.Show); ok {
+		r0 = rf(ctx, accessToken, market, showID)
+	} else {
+		r0 = ret.Get(0).(model.Show)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, showID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShowEpisodes provides a mock function with given fields: ctx, accessToken, market, limit, offset, showID
+func (_m *ShowsResource) GetShowEpisodes(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, showID model.ID) (model.SimplifiedEpisodesPaginated, error) {
+	ret := _m.Called(ctx, accessToken, market, limit, offset, showID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShowEpisodes")
+	}
+
+	var r0 model.SimplifiedEpisodesPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) (model.SimplifiedEpisodesPaginated, error)); ok {
+		return rf(ctx, accessToken, market, limit, offset, showID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) model.SimplifiedEpisodesPaginated); ok {
+		r0 = rf(ctx, accessToken, market, limit, offset, showID)
+	} else {
+		r0 = ret.Get(0).(model.SimplifiedEpisodesPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, *model.Limit, *model.Offset, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, limit, offset, showID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShows provides a mock function with given fields: ctx, accessToken, market, showsIDs
+func (_m *ShowsResource) GetShows(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, showsIDs model.ShowsIDs) ([]model.SimplifiedShow, error) {
+	ret := _m.Called(ctx, accessToken, market, showsIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShows")
+	}
+
+	var r0 []model.SimplifiedShow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ShowsIDs) ([]model.SimplifiedShow, error)); ok {
+		return rf(ctx, accessToken, market, showsIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ShowsIDs) []model.SimplifiedShow); ok {
+		r0 = rf(ctx, accessToken, market, showsIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SimplifiedShow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ShowsIDs) error); ok {
+		r1 = rf(ctx, accessToken, market, showsIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewShowsResource creates a new instance of ShowsResource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShowsResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShowsResource {
+	mock := &ShowsResource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
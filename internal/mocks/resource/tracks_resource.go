@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	model "jezz-go-spotify-integration/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type TracksResource struct {
 	mock.Mock
 }
 
-// GetTrack provides a mock function with given fields: accessToken, market, trackID
-func (_m *TracksResource) GetTrack(accessToken model.AccessToken, market *model.AvailableMarket, trackID model.ID) (model.Track, error) {
-	ret := _m.Called(accessToken, market, trackID)
+// GetTrack provides a mock function with given fields: ctx, accessToken, market, trackID
+func (_m *TracksResource) GetTrack(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, trackID model.ID) (model.Track, error) {
+	ret := _m.Called(ctx, accessToken, market, trackID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTrack")
@@ -23,17 +24,17 @@ func (_m *TracksResource) GetTrack(accessToken model.AccessToken, market *model.
 
 	var r0 model.Track
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.ID) (model.Track, error)); ok {
-		return rf(accessToken, market, trackID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) (model.Track, error)); ok {
+		return rf(ctx, accessToken, market, trackID)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.ID) model.Track); ok {
-		r0 = rf(accessToken, market, trackID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) model.Track); ok {
+		r0 = rf(ctx, accessToken, market, trackID)
 	} else {
 		r0 = ret.Get(0).(model.Track)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
-		r1 = rf(accessToken, market, trackID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.ID) error); ok {
+		r1 = rf(ctx, accessToken, market, trackID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +42,9 @@ func (_m *TracksResource) GetTrack(accessToken model.AccessToken, market *model.
 	return r0, r1
 }
 
-// GetTracks provides a mock function with given fields: accessToken, market, tracksIDs
-func (_m *TracksResource) GetTracks(accessToken model.AccessToken, market *model.AvailableMarket, tracksIDs model.TracksIDs) ([]model.Track, error) {
-	ret := _m.Called(accessToken, market, tracksIDs)
+// GetTracks provides a mock function with given fields: ctx, accessToken, market, tracksIDs
+func (_m *TracksResource) GetTracks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, tracksIDs model.TracksIDs) ([]model.Track, error) {
+	ret := _m.Called(ctx, accessToken, market, tracksIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTracks")
@@ -51,19 +52,19 @@ func (_m *TracksResource) GetTracks(accessToken model.AccessToken, market *model
 
 	var r0 []model.Track
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.TracksIDs) ([]model.Track, error)); ok {
-		return rf(accessToken, market, tracksIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.TracksIDs) ([]model.Track, error)); ok {
+		return rf(ctx, accessToken, market, tracksIDs)
 	}
-	if rf, ok := ret.Get(0).(func(model.AccessToken, *model.AvailableMarket, model.TracksIDs) []model.Track); ok {
-		r0 = rf(accessToken, market, tracksIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.TracksIDs) []model.Track); ok {
+		r0 = rf(ctx, accessToken, market, tracksIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Track)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(model.AccessToken, *model.AvailableMarket, model.TracksIDs) error); ok {
-		r1 = rf(accessToken, market, tracksIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccessToken, *model.AvailableMarket, model.TracksIDs) error); ok {
+		r1 = rf(ctx, accessToken, market, tracksIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
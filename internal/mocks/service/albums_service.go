@@ -3,9 +3,12 @@
 package mocks
 
 import (
-	model "jezz-go-spotify-integration/internal/model"
+	context "context"
+	iter "iter"
 
 	mock "github.com/stretchr/testify/mock"
+
+	model "jezz-go-spotify-integration/internal/model"
 )
 
 // AlbumsService is an autogenerated mock type for the AlbumsService type
@@ -13,9 +16,29 @@ type AlbumsService struct {
 	mock.Mock
 }
 
-// GetAlbum provides a mock function with given fields: countryMarketName, albumID
-func (_m *AlbumsService) GetAlbum(countryMarketName *string, albumID string) (model.Album, error) {
-	ret := _m.Called(countryMarketName, albumID)
+// AlbumTracksSeq provides a mock function with given fields: ctx, countryMarketName, maxItems, albumID
+func (_m *AlbumsService) AlbumTracksSeq(ctx context.Context, countryMarketName *string, maxItems int, albumID string) iter.Seq2[model.SimplifiedTrack, error] {
+	ret := _m.Called(ctx, countryMarketName, maxItems, albumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlbumTracksSeq")
+	}
+
+	var r0 iter.Seq2[model.SimplifiedTrack, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int, string) iter.Seq2[model.SimplifiedTrack, error]); ok {
+		r0 = rf(ctx, countryMarketName, maxItems, albumID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[model.SimplifiedTrack, error])
+		}
+	}
+
+	return r0
+}
+
+// GetAlbum provides a mock function with given fields: ctx, countryMarketName, albumID
+func (_m *AlbumsService) GetAlbum(ctx context.Context, countryMarketName *string, albumID string) (model.Album, error) {
+	ret := _m.Called(ctx, countryMarketName, albumID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAlbum")
@@ -23,17 +46,17 @@ func (_m *AlbumsService) GetAlbum(countryMarketName *string, albumID string) (mo
 
 	var r0 model.Album
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, string) (model.Album, error)); ok {
-		return rf(countryMarketName, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Album, error)); ok {
+		return rf(ctx, countryMarketName, albumID)
 	}
-	if rf, ok := ret.Get(0).(func(*string, string) model.Album); ok {
-		r0 = rf(countryMarketName, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Album); ok {
+		r0 = rf(ctx, countryMarketName, albumID)
 	} else {
 		r0 = ret.Get(0).(model.Album)
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, string) error); ok {
-		r1 = rf(countryMarketName, albumID)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, albumID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +64,9 @@ func (_m *AlbumsService) GetAlbum(countryMarketName *string, albumID string) (mo
 	return r0, r1
 }
 
-// GetAlbumTracks provides a mock function with given fields: countryMarketName, limit, offset, albumID
-func (_m *AlbumsService) GetAlbumTracks(countryMarketName *string, limit *int, offset *int, albumID string) (model.SimplifiedTracksPaginated, error) {
-	ret := _m.Called(countryMarketName, limit, offset, albumID)
+// GetAlbumTracks provides a mock function with given fields: ctx, countryMarketName, limit, offset, albumID
+func (_m *AlbumsService) GetAlbumTracks(ctx context.Context, countryMarketName *string, limit *int, offset *int, albumID string) (model.SimplifiedTracksPaginated, error) {
+	ret := _m.Called(ctx, countryMarketName, limit, offset, albumID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAlbumTracks")
@@ -51,17 +74,17 @@ func (_m *AlbumsService) GetAlbumTracks(countryMarketName *string, limit *int, o
 
 	var r0 model.SimplifiedTracksPaginated
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, *int, *int, string) (model.SimplifiedTracksPaginated, error)); ok {
-		return rf(countryMarketName, limit, offset, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int, string) (model.SimplifiedTracksPaginated, error)); ok {
+		return rf(ctx, countryMarketName, limit, offset, albumID)
 	}
-	if rf, ok := ret.Get(0).(func(*string, *int, *int, string) model.SimplifiedTracksPaginated); ok {
-		r0 = rf(countryMarketName, limit, offset, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int, string) model.SimplifiedTracksPaginated); ok {
+		r0 = rf(ctx, countryMarketName, limit, offset, albumID)
 	} else {
 		r0 = ret.Get(0).(model.SimplifiedTracksPaginated)
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, *int, *int, string) error); ok {
-		r1 = rf(countryMarketName, limit, offset, albumID)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int, *int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, limit, offset, albumID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,14 +92,14 @@ func (_m *AlbumsService) GetAlbumTracks(countryMarketName *string, limit *int, o
 	return r0, r1
 }
 
-// GetAlbums provides a mock function with given fields: countryMarketName, albumsIDs
-func (_m *AlbumsService) GetAlbums(countryMarketName *string, albumsIDs ...string) ([]model.Album, error) {
+// GetAlbums provides a mock function with given fields: ctx, countryMarketName, albumsIDs
+func (_m *AlbumsService) GetAlbums(ctx context.Context, countryMarketName *string, albumsIDs ...string) ([]model.Album, error) {
 	_va := make([]interface{}, len(albumsIDs))
 	for _i := range albumsIDs {
 		_va[_i] = albumsIDs[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, countryMarketName)
+	_ca = append(_ca, ctx, countryMarketName)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
@@ -86,19 +109,19 @@ func (_m *AlbumsService) GetAlbums(countryMarketName *string, albumsIDs ...strin
 
 	var r0 []model.Album
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, ...string) ([]model.Album, error)); ok {
-		return rf(countryMarketName, albumsIDs...)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) ([]model.Album, error)); ok {
+		return rf(ctx, countryMarketName, albumsIDs...)
 	}
-	if rf, ok := ret.Get(0).(func(*string, ...string) []model.Album); ok {
-		r0 = rf(countryMarketName, albumsIDs...)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) []model.Album); ok {
+		r0 = rf(ctx, countryMarketName, albumsIDs...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Album)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, ...string) error); ok {
-		r1 = rf(countryMarketName, albumsIDs...)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, ...string) error); ok {
+		r1 = rf(ctx, countryMarketName, albumsIDs...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,9 +129,69 @@ func (_m *AlbumsService) GetAlbums(countryMarketName *string, albumsIDs ...strin
 	return r0, r1
 }
 
-// GetNewReleases provides a mock function with given fields: limit, offset
-func (_m *AlbumsService) GetNewReleases(limit *int, offset *int) (model.AlbumsNewRelease, error) {
-	ret := _m.Called(limit, offset)
+// GetAllAlbumTracks provides a mock function with given fields: ctx, countryMarketName, maxItems, albumID
+func (_m *AlbumsService) GetAllAlbumTracks(ctx context.Context, countryMarketName *string, maxItems int, albumID string) ([]model.SimplifiedTrack, error) {
+	ret := _m.Called(ctx, countryMarketName, maxItems, albumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllAlbumTracks")
+	}
+
+	var r0 []model.SimplifiedTrack
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int, string) ([]model.SimplifiedTrack, error)); ok {
+		return rf(ctx, countryMarketName, maxItems, albumID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int, string) []model.SimplifiedTrack); ok {
+		r0 = rf(ctx, countryMarketName, maxItems, albumID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SimplifiedTrack)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, maxItems, albumID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllNewReleases provides a mock function with given fields: ctx, maxItems
+func (_m *AlbumsService) GetAllNewReleases(ctx context.Context, maxItems int) ([]model.SimplifiedAlbum, error) {
+	ret := _m.Called(ctx, maxItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllNewReleases")
+	}
+
+	var r0 []model.SimplifiedAlbum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.SimplifiedAlbum, error)); ok {
+		return rf(ctx, maxItems)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.SimplifiedAlbum); ok {
+		r0 = rf(ctx, maxItems)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SimplifiedAlbum)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, maxItems)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNewReleases provides a mock function with given fields: ctx, limit, offset
+func (_m *AlbumsService) GetNewReleases(ctx context.Context, limit *int, offset *int) (model.AlbumsNewRelease, error) {
+	ret := _m.Called(ctx, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNewReleases")
@@ -116,17 +199,17 @@ func (_m *AlbumsService) GetNewReleases(limit *int, offset *int) (model.AlbumsNe
 
 	var r0 model.AlbumsNewRelease
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*int, *int) (model.AlbumsNewRelease, error)); ok {
-		return rf(limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *int, *int) (model.AlbumsNewRelease, error)); ok {
+		return rf(ctx, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(*int, *int) model.AlbumsNewRelease); ok {
-		r0 = rf(limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *int, *int) model.AlbumsNewRelease); ok {
+		r0 = rf(ctx, limit, offset)
 	} else {
 		r0 = ret.Get(0).(model.AlbumsNewRelease)
 	}
 
-	if rf, ok := ret.Get(1).(func(*int, *int) error); ok {
-		r1 = rf(limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, *int, *int) error); ok {
+		r1 = rf(ctx, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -134,6 +217,26 @@ func (_m *AlbumsService) GetNewReleases(limit *int, offset *int) (model.AlbumsNe
 	return r0, r1
 }
 
+// NewReleasesSeq provides a mock function with given fields: ctx, maxItems
+func (_m *AlbumsService) NewReleasesSeq(ctx context.Context, maxItems int) iter.Seq2[model.SimplifiedAlbum, error] {
+	ret := _m.Called(ctx, maxItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewReleasesSeq")
+	}
+
+	var r0 iter.Seq2[model.SimplifiedAlbum, error]
+	if rf, ok := ret.Get(0).(func(context.Context, int) iter.Seq2[model.SimplifiedAlbum, error]); ok {
+		r0 = rf(ctx, maxItems)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[model.SimplifiedAlbum, error])
+		}
+	}
+
+	return r0
+}
+
 // NewAlbumsService creates a new instance of AlbumsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAlbumsService(t interface {
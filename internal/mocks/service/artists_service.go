@@ -3,9 +3,12 @@
 package mocks
 
 import (
-	model "jezz-go-spotify-integration/internal/model"
+	context "context"
+	iter "iter"
 
 	mock "github.com/stretchr/testify/mock"
+
+	model "jezz-go-spotify-integration/internal/model"
 )
 
 // ArtistsService is an autogenerated mock type for the ArtistsService type
@@ -13,9 +16,59 @@ type ArtistsService struct {
 	mock.Mock
 }
 
-// GetArtist provides a mock function with given fields: artistID
-func (_m *ArtistsService) GetArtist(artistID string) (model.Artist, error) {
-	ret := _m.Called(artistID)
+// ArtistAlbumsSeq provides a mock function with given fields: ctx, countryMarketName, albumTypes, maxItems, artistID
+func (_m *ArtistsService) ArtistAlbumsSeq(ctx context.Context, countryMarketName *string, albumTypes *[]string, maxItems int, artistID string) iter.Seq2[model.SimplifiedArtistAlbum, error] {
+	ret := _m.Called(ctx, countryMarketName, albumTypes, maxItems, artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistAlbumsSeq")
+	}
+
+	var r0 iter.Seq2[model.SimplifiedArtistAlbum, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, int, string) iter.Seq2[model.SimplifiedArtistAlbum, error]); ok {
+		r0 = rf(ctx, countryMarketName, albumTypes, maxItems, artistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[model.SimplifiedArtistAlbum, error])
+		}
+	}
+
+	return r0
+}
+
+// GetAllArtistAlbums provides a mock function with given fields: ctx, countryMarketName, albumTypes, maxItems, artistID
+func (_m *ArtistsService) GetAllArtistAlbums(ctx context.Context, countryMarketName *string, albumTypes *[]string, maxItems int, artistID string) ([]model.SimplifiedArtistAlbum, error) {
+	ret := _m.Called(ctx, countryMarketName, albumTypes, maxItems, artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllArtistAlbums")
+	}
+
+	var r0 []model.SimplifiedArtistAlbum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, int, string) ([]model.SimplifiedArtistAlbum, error)); ok {
+		return rf(ctx, countryMarketName, albumTypes, maxItems, artistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, int, string) []model.SimplifiedArtistAlbum); ok {
+		r0 = rf(ctx, countryMarketName, albumTypes, maxItems, artistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SimplifiedArtistAlbum)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *[]string, int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, albumTypes, maxItems, artistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetArtist provides a mock function with given fields: ctx, artistID
+func (_m *ArtistsService) GetArtist(ctx context.Context, artistID string) (model.Artist, error) {
+	ret := _m.Called(ctx, artistID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtist")
@@ -23,17 +76,17 @@ func (_m *ArtistsService) GetArtist(artistID string) (model.Artist, error) {
 
 	var r0 model.Artist
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (model.Artist, error)); ok {
-		return rf(artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Artist, error)); ok {
+		return rf(ctx, artistID)
 	}
-	if rf, ok := ret.Get(0).(func(string) model.Artist); ok {
-		r0 = rf(artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Artist); ok {
+		r0 = rf(ctx, artistID)
 	} else {
 		r0 = ret.Get(0).(model.Artist)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(artistID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, artistID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +94,9 @@ func (_m *ArtistsService) GetArtist(artistID string) (model.Artist, error) {
 	return r0, r1
 }
 
-// GetArtistAlbums provides a mock function with given fields: countryMarketName, albumTypes, limit, offset, albumID
-func (_m *ArtistsService) GetArtistAlbums(countryMarketName *string, albumTypes *[]string, limit *int, offset *int, albumID string) (model.SimplifiedArtistAlbumsPaginated, error) {
-	ret := _m.Called(countryMarketName, albumTypes, limit, offset, albumID)
+// GetArtistAlbums provides a mock function with given fields: ctx, countryMarketName, albumTypes, limit, offset, albumID
+func (_m *ArtistsService) GetArtistAlbums(ctx context.Context, countryMarketName *string, albumTypes *[]string, limit *int, offset *int, albumID string) (model.SimplifiedArtistAlbumsPaginated, error) {
+	ret := _m.Called(ctx, countryMarketName, albumTypes, limit, offset, albumID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtistAlbums")
@@ -51,17 +104,17 @@ func (_m *ArtistsService) GetArtistAlbums(countryMarketName *string, albumTypes
 
 	var r0 model.SimplifiedArtistAlbumsPaginated
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, *[]string, *int, *int, string) (model.SimplifiedArtistAlbumsPaginated, error)); ok {
-		return rf(countryMarketName, albumTypes, limit, offset, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, *int, *int, string) (model.SimplifiedArtistAlbumsPaginated, error)); ok {
+		return rf(ctx, countryMarketName, albumTypes, limit, offset, albumID)
 	}
-	if rf, ok := ret.Get(0).(func(*string, *[]string, *int, *int, string) model.SimplifiedArtistAlbumsPaginated); ok {
-		r0 = rf(countryMarketName, albumTypes, limit, offset, albumID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, *int, *int, string) model.SimplifiedArtistAlbumsPaginated); ok {
+		r0 = rf(ctx, countryMarketName, albumTypes, limit, offset, albumID)
 	} else {
 		r0 = ret.Get(0).(model.SimplifiedArtistAlbumsPaginated)
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, *[]string, *int, *int, string) error); ok {
-		r1 = rf(countryMarketName, albumTypes, limit, offset, albumID)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *[]string, *int, *int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, albumTypes, limit, offset, albumID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -69,9 +122,9 @@ func (_m *ArtistsService) GetArtistAlbums(countryMarketName *string, albumTypes
 	return r0, r1
 }
 
-// GetArtistTopTracks provides a mock function with given fields: countryMarketName, artistID
-func (_m *ArtistsService) GetArtistTopTracks(countryMarketName *string, artistID string) ([]model.Track, error) {
-	ret := _m.Called(countryMarketName, artistID)
+// GetArtistTopTracks provides a mock function with given fields: ctx, countryMarketName, artistID
+func (_m *ArtistsService) GetArtistTopTracks(ctx context.Context, countryMarketName *string, artistID string) ([]model.Track, error) {
+	ret := _m.Called(ctx, countryMarketName, artistID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetArtistTopTracks")
@@ -79,19 +132,19 @@ func (_m *ArtistsService) GetArtistTopTracks(countryMarketName *string, artistID
 
 	var r0 []model.Track
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, string) ([]model.Track, error)); ok {
-		return rf(countryMarketName, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) ([]model.Track, error)); ok {
+		return rf(ctx, countryMarketName, artistID)
 	}
-	if rf, ok := ret.Get(0).(func(*string, string) []model.Track); ok {
-		r0 = rf(countryMarketName, artistID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) []model.Track); ok {
+		r0 = rf(ctx, countryMarketName, artistID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Track)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, string) error); ok {
-		r1 = rf(countryMarketName, artistID)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, artistID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,13 +152,14 @@ func (_m *ArtistsService) GetArtistTopTracks(countryMarketName *string, artistID
 	return r0, r1
 }
 
-// GetArtists provides a mock function with given fields: artistIDsStr
-func (_m *ArtistsService) GetArtists(artistIDsStr ...string) ([]model.Artist, error) {
+// GetArtists provides a mock function with given fields: ctx, artistIDsStr
+func (_m *ArtistsService) GetArtists(ctx context.Context, artistIDsStr ...string) ([]model.Artist, error) {
 	_va := make([]interface{}, len(artistIDsStr))
 	for _i := range artistIDsStr {
 		_va[_i] = artistIDsStr[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, ctx)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
@@ -115,19 +169,19 @@ func (_m *ArtistsService) GetArtists(artistIDsStr ...string) ([]model.Artist, er
 
 	var r0 []model.Artist
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...string) ([]model.Artist, error)); ok {
-		return rf(artistIDsStr...)
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) ([]model.Artist, error)); ok {
+		return rf(ctx, artistIDsStr...)
 	}
-	if rf, ok := ret.Get(0).(func(...string) []model.Artist); ok {
-		r0 = rf(artistIDsStr...)
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) []model.Artist); ok {
+		r0 = rf(ctx, artistIDsStr...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Artist)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...string) error); ok {
-		r1 = rf(artistIDsStr...)
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, artistIDsStr...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,122 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AudiobooksService is an autogenerated mock type for the AudiobooksService type
+type AudiobooksService struct {
+	mock.Mock
+}
+
+// GetAudiobook provides a mock function with given fields: ctx, countryMarketName, audiobookID
+func (_m *AudiobooksService) GetAudiobook(ctx context.Context, countryMarketName *string, audiobookID string) (model.Audiobook, error) {
+	ret := _m.Called(ctx, countryMarketName, audiobookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudiobook")
+	}
+
+	var r0 model.Audiobook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Audiobook, error)); ok {
+		return rf(ctx, countryMarketName, audiobookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Audiobook); ok {
+		r0 = rf(ctx, countryMarketName, audiobookID)
+	} else {
+		r0 = ret.Get(0).(model.Audiobook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, audiobookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAudiobookChapters provides a mock function with given fields: ctx, countryMarketName, limit, offset, audiobookID
+func (_m *AudiobooksService) GetAudiobookChapters(ctx context.Context, countryMarketName *string, limit *int, offset *int, audiobookID string) (model.SimplifiedChaptersPaginated, error) {
+	ret := _m.Called(ctx, countryMarketName, limit, offset, audiobookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudiobookChapters")
+	}
+
+	var r0 model.SimplifiedChaptersPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int, string) (model.SimplifiedChaptersPaginated, error)); ok {
+		return rf(ctx, countryMarketName, limit, offset, audiobookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int, string) model.SimplifiedChaptersPaginated); ok {
+		r0 = rf(ctx, countryMarketName, limit, offset, audiobookID)
+	} else {
+		r0 = ret.Get(0).(model.SimplifiedChaptersPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int, *int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, limit, offset, audiobookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAudiobooks provides a mock function with given fields: ctx, countryMarketName, audiobooksIDs
+func (_m *AudiobooksService) GetAudiobooks(ctx context.Context, countryMarketName *string, audiobooksIDs ...string) ([]model.Audiobook, error) {
+	_va := make([]interface{}, len(audiobooksIDs))
+	for _i := range audiobooksIDs {
+		_va[_i] = audiobooksIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, countryMarketName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudiobooks")
+	}
+
+	var r0 []model.Audiobook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) ([]model.Audiobook, error)); ok {
+		return rf(ctx, countryMarketName, audiobooksIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) []model.Audiobook); ok {
+		r0 = rf(ctx, countryMarketName, audiobooksIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Audiobook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, ...string) error); ok {
+		r1 = rf(ctx, countryMarketName, audiobooksIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAudiobooksService creates a new instance of AudiobooksService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAudiobooksService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AudiobooksService {
+	mock := &AudiobooksService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
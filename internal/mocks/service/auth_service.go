@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	service "jezz-go-spotify-integration/internal/service"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type AuthService struct {
 	mock.Mock
 }
 
-// ExecuteWithAuthentication provides a mock function with given fields: fn
-func (_m *AuthService) ExecuteWithAuthentication(fn service.ExecuteWithAuthenticationFn) (interface{}, error) {
-	ret := _m.Called(fn)
+// ExecuteWithAuthentication provides a mock function with given fields: ctx, fn
+func (_m *AuthService) ExecuteWithAuthentication(ctx context.Context, fn service.ExecuteWithAuthenticationFn) (interface{}, error) {
+	ret := _m.Called(ctx, fn)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ExecuteWithAuthentication")
@@ -23,19 +24,19 @@ func (_m *AuthService) ExecuteWithAuthentication(fn service.ExecuteWithAuthentic
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(service.ExecuteWithAuthenticationFn) (interface{}, error)); ok {
-		return rf(fn)
+	if rf, ok := ret.Get(0).(func(context.Context, service.ExecuteWithAuthenticationFn) (interface{}, error)); ok {
+		return rf(ctx, fn)
 	}
-	if rf, ok := ret.Get(0).(func(service.ExecuteWithAuthenticationFn) interface{}); ok {
-		r0 = rf(fn)
+	if rf, ok := ret.Get(0).(func(context.Context, service.ExecuteWithAuthenticationFn) interface{}); ok {
+		r0 = rf(ctx, fn)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(service.ExecuteWithAuthenticationFn) error); ok {
-		r1 = rf(fn)
+	if rf, ok := ret.Get(1).(func(context.Context, service.ExecuteWithAuthenticationFn) error); ok {
+		r1 = rf(ctx, fn)
 	} else {
 		r1 = ret.Error(1)
 	}
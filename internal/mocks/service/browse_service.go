@@ -0,0 +1,137 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "jezz-go-spotify-integration/internal/model"
+)
+
+// BrowseService is an autogenerated mock type for the BrowseService type
+type BrowseService struct {
+	mock.Mock
+}
+
+// CategoriesSeq provides a mock function with given fields: ctx, locale, maxItems
+func (_m *BrowseService) CategoriesSeq(ctx context.Context, locale *string, maxItems int) iter.Seq2[model.Category, error] {
+	ret := _m.Called(ctx, locale, maxItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoriesSeq")
+	}
+
+	var r0 iter.Seq2[model.Category, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int) iter.Seq2[model.Category, error]); ok {
+		r0 = rf(ctx, locale, maxItems)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[model.Category, error])
+		}
+	}
+
+	return r0
+}
+
+// GetAllCategories provides a mock function with given fields: ctx, locale, maxItems
+func (_m *BrowseService) GetAllCategories(ctx context.Context, locale *string, maxItems int) ([]model.Category, error) {
+	ret := _m.Called(ctx, locale, maxItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCategories")
+	}
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int) ([]model.Category, error)); ok {
+		return rf(ctx, locale, maxItems)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, int) []model.Category); ok {
+		r0 = rf(ctx, locale, maxItems)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, int) error); ok {
+		r1 = rf(ctx, locale, maxItems)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategories provides a mock function with given fields: ctx, locale, limit, offset
+func (_m *BrowseService) GetCategories(ctx context.Context, locale *string, limit *int, offset *int) (model.CategoriesPaginated, error) {
+	ret := _m.Called(ctx, locale, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 model.CategoriesPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int) (model.CategoriesPaginated, error)); ok {
+		return rf(ctx, locale, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int) model.CategoriesPaginated); ok {
+		r0 = rf(ctx, locale, limit, offset)
+	} else {
+		r0 = ret.Get(0).(model.CategoriesPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int, *int) error); ok {
+		r1 = rf(ctx, locale, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategory provides a mock function with given fields: ctx, locale, categoryID
+func (_m *BrowseService) GetCategory(ctx context.Context, locale *string, categoryID string) (model.Category, error) {
+	ret := _m.Called(ctx, locale, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Category, error)); ok {
+		return rf(ctx, locale, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Category); ok {
+		r0 = rf(ctx, locale, categoryID)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, locale, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBrowseService creates a new instance of BrowseService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBrowseService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BrowseService {
+	mock := &BrowseService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
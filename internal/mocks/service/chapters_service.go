@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChaptersService is an autogenerated mock type for the ChaptersService type
+type ChaptersService struct {
+	mock.Mock
+}
+
+// GetChapter provides a mock function with given fields: ctx, countryMarketName, chapterID
+func (_m *ChaptersService) GetChapter(ctx context.Context, countryMarketName *string, chapterID string) (model.Chapter, error) {
+	ret := _m.Called(ctx, countryMarketName, chapterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChapter")
+	}
+
+	var r0 model.Chapter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Chapter, error)); ok {
+		return rf(ctx, countryMarketName, chapterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Chapter); ok {
+		r0 = rf(ctx, countryMarketName, chapterID)
+	} else {
+		r0 = ret.Get(0).(model.Chapter)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, chapterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChapters provides a mock function with given fields: ctx, countryMarketName, chaptersIDs
+func (_m *ChaptersService) GetChapters(ctx context.Context, countryMarketName *string, chaptersIDs ...string) ([]model.Chapter, error) {
+	_va := make([]interface{}, len(chaptersIDs))
+	for _i := range chaptersIDs {
+		_va[_i] = chaptersIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, countryMarketName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChapters")
+	}
+
+	var r0 []model.Chapter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) ([]model.Chapter, error)); ok {
+		return rf(ctx, countryMarketName, chaptersIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) []model.Chapter); ok {
+		r0 = rf(ctx, countryMarketName, chaptersIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Chapter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, ...string) error); ok {
+		r1 = rf(ctx, countryMarketName, chaptersIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewChaptersService creates a new instance of ChaptersService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChaptersService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChaptersService {
+	mock := &ChaptersService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EpisodesService is an autogenerated mock type for the EpisodesService type
+type EpisodesService struct {
+	mock.Mock
+}
+
+// GetEpisode provides a mock function with given fields: ctx, countryMarketName, episodeID
+func (_m *EpisodesService) GetEpisode(ctx context.Context, countryMarketName *string, episodeID string) (model.Episode, error) {
+	ret := _m.Called(ctx, countryMarketName, episodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpisode")
+	}
+
+	var r0 model.Episode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Episode, error)); ok {
+		return rf(ctx, countryMarketName, episodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Episode); ok {
+		r0 = rf(ctx, countryMarketName, episodeID)
+	} else {
+		r0 = ret.Get(0).(model.Episode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, episodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEpisodes provides a mock function with given fields: ctx, countryMarketName, episodesIDs
+func (_m *EpisodesService) GetEpisodes(ctx context.Context, countryMarketName *string, episodesIDs ...string) ([]model.Episode, error) {
+	_va := make([]interface{}, len(episodesIDs))
+	for _i := range episodesIDs {
+		_va[_i] = episodesIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, countryMarketName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpisodes")
+	}
+
+	var r0 []model.Episode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) ([]model.Episode, error)); ok {
+		return rf(ctx, countryMarketName, episodesIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) []model.Episode); ok {
+		r0 = rf(ctx, countryMarketName, episodesIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Episode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, ...string) error); ok {
+		r1 = rf(ctx, countryMarketName, episodesIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEpisodesService creates a new instance of EpisodesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEpisodesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EpisodesService {
+	mock := &EpisodesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
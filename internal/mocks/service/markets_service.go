@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarketsService is an autogenerated mock type for the MarketsService type
+type MarketsService struct {
+	mock.Mock
+}
+
+// GetAvailableMarkets provides a mock function with given fields: ctx
+func (_m *MarketsService) GetAvailableMarkets(ctx context.Context) ([]model.AvailableMarket, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableMarkets")
+	}
+
+	var r0 []model.AvailableMarket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.AvailableMarket, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.AvailableMarket); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AvailableMarket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMarketsService creates a new instance of MarketsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarketsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarketsService {
+	mock := &MarketsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
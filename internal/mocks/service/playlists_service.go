@@ -0,0 +1,346 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "jezz-go-spotify-integration/internal/model"
+)
+
+// PlaylistsService is an autogenerated mock type for the PlaylistsService type
+type PlaylistsService struct {
+	mock.Mock
+}
+
+// AddPlaylistItems provides a mock function with given fields: ctx, playlistID, position, uris
+func (_m *PlaylistsService) AddPlaylistItems(ctx context.Context, playlistID string, position *int, uris ...string) (model.SnapshotID, error) {
+	_va := make([]interface{}, len(uris))
+	for _i := range uris {
+		_va[_i] = uris[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, playlistID, position)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int, ...string) (model.SnapshotID, error)); ok {
+		return rf(ctx, playlistID, position, uris...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int, ...string) model.SnapshotID); ok {
+		r0 = rf(ctx, playlistID, position, uris...)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int, ...string) error); ok {
+		r1 = rf(ctx, playlistID, position, uris...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangePlaylistDetails provides a mock function with given fields: ctx, playlistID, name, description, public, collaborative
+func (_m *PlaylistsService) ChangePlaylistDetails(ctx context.Context, playlistID string, name *string, description *string, public *bool, collaborative *bool) error {
+	ret := _m.Called(ctx, playlistID, name, description, public, collaborative)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePlaylistDetails")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, *string, *bool, *bool) error); ok {
+		r0 = rf(ctx, playlistID, name, description, public, collaborative)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePlaylist provides a mock function with given fields: ctx, userID, name, description, public, collaborative
+func (_m *PlaylistsService) CreatePlaylist(ctx context.Context, userID string, name string, description *string, public *bool, collaborative *bool) (model.Playlist, error) {
+	ret := _m.Called(ctx, userID, name, description, public, collaborative)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string, *bool, *bool) (model.Playlist, error)); ok {
+		return rf(ctx, userID, name, description, public, collaborative)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string, *bool, *bool) model.Playlist); ok {
+		r0 = rf(ctx, userID, name, description, public, collaborative)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *string, *bool, *bool) error); ok {
+		r1 = rf(ctx, userID, name, description, public, collaborative)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPlaylistItems provides a mock function with given fields: ctx, countryMarketName, additionalTypes, maxItems, playlistID
+func (_m *PlaylistsService) GetAllPlaylistItems(ctx context.Context, countryMarketName *string, additionalTypes *[]string, maxItems int, playlistID string) ([]model.PlaylistItem, error) {
+	ret := _m.Called(ctx, countryMarketName, additionalTypes, maxItems, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPlaylistItems")
+	}
+
+	var r0 []model.PlaylistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, int, string) ([]model.PlaylistItem, error)); ok {
+		return rf(ctx, countryMarketName, additionalTypes, maxItems, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, int, string) []model.PlaylistItem); ok {
+		r0 = rf(ctx, countryMarketName, additionalTypes, maxItems, playlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PlaylistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *[]string, int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, additionalTypes, maxItems, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylist provides a mock function with given fields: ctx, countryMarketName, fields, additionalTypes, playlistID
+func (_m *PlaylistsService) GetPlaylist(ctx context.Context, countryMarketName *string, fields *string, additionalTypes *[]string, playlistID string) (model.Playlist, error) {
+	ret := _m.Called(ctx, countryMarketName, fields, additionalTypes, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string, *[]string, string) (model.Playlist, error)); ok {
+		return rf(ctx, countryMarketName, fields, additionalTypes, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string, *[]string, string) model.Playlist); ok {
+		r0 = rf(ctx, countryMarketName, fields, additionalTypes, playlistID)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string, *[]string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, fields, additionalTypes, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylistCoverImage provides a mock function with given fields: ctx, playlistID
+func (_m *PlaylistsService) GetPlaylistCoverImage(ctx context.Context, playlistID string) ([]model.Image, error) {
+	ret := _m.Called(ctx, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylistCoverImage")
+	}
+
+	var r0 []model.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Image, error)); ok {
+		return rf(ctx, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Image); ok {
+		r0 = rf(ctx, playlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylistItems provides a mock function with given fields: ctx, countryMarketName, fields, additionalTypes, limit, offset, playlistID
+func (_m *PlaylistsService) GetPlaylistItems(ctx context.Context, countryMarketName *string, fields *string, additionalTypes *[]string, limit *int, offset *int, playlistID string) (model.PlaylistItemsPaginated, error) {
+	ret := _m.Called(ctx, countryMarketName, fields, additionalTypes, limit, offset, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylistItems")
+	}
+
+	var r0 model.PlaylistItemsPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string, *[]string, *int, *int, string) (model.PlaylistItemsPaginated, error)); ok {
+		return rf(ctx, countryMarketName, fields, additionalTypes, limit, offset, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string, *[]string, *int, *int, string) model.PlaylistItemsPaginated); ok {
+		r0 = rf(ctx, countryMarketName, fields, additionalTypes, limit, offset, playlistID)
+	} else {
+		r0 = ret.Get(0).(model.PlaylistItemsPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string, *[]string, *int, *int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, fields, additionalTypes, limit, offset, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlaylistItemsSeq provides a mock function with given fields: ctx, countryMarketName, additionalTypes, maxItems, playlistID
+func (_m *PlaylistsService) PlaylistItemsSeq(ctx context.Context, countryMarketName *string, additionalTypes *[]string, maxItems int, playlistID string) iter.Seq2[model.PlaylistItem, error] {
+	ret := _m.Called(ctx, countryMarketName, additionalTypes, maxItems, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaylistItemsSeq")
+	}
+
+	var r0 iter.Seq2[model.PlaylistItem, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *[]string, int, string) iter.Seq2[model.PlaylistItem, error]); ok {
+		r0 = rf(ctx, countryMarketName, additionalTypes, maxItems, playlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[model.PlaylistItem, error])
+		}
+	}
+
+	return r0
+}
+
+// RemovePlaylistItems provides a mock function with given fields: ctx, playlistID, snapshotID, uris
+func (_m *PlaylistsService) RemovePlaylistItems(ctx context.Context, playlistID string, snapshotID *string, uris ...string) (model.SnapshotID, error) {
+	_va := make([]interface{}, len(uris))
+	for _i := range uris {
+		_va[_i] = uris[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, playlistID, snapshotID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, ...string) (model.SnapshotID, error)); ok {
+		return rf(ctx, playlistID, snapshotID, uris...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, ...string) model.SnapshotID); ok {
+		r0 = rf(ctx, playlistID, snapshotID, uris...)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, ...string) error); ok {
+		r1 = rf(ctx, playlistID, snapshotID, uris...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReorderPlaylistItems provides a mock function with given fields: ctx, playlistID, rangeStart, rangeLength, insertBefore, snapshotID
+func (_m *PlaylistsService) ReorderPlaylistItems(ctx context.Context, playlistID string, rangeStart int, rangeLength int, insertBefore int, snapshotID *string) (model.SnapshotID, error) {
+	ret := _m.Called(ctx, playlistID, rangeStart, rangeLength, insertBefore, snapshotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderPlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, *string) (model.SnapshotID, error)); ok {
+		return rf(ctx, playlistID, rangeStart, rangeLength, insertBefore, snapshotID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, *string) model.SnapshotID); ok {
+		r0 = rf(ctx, playlistID, rangeStart, rangeLength, insertBefore, snapshotID)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, int, *string) error); ok {
+		r1 = rf(ctx, playlistID, rangeStart, rangeLength, insertBefore, snapshotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplacePlaylistItems provides a mock function with given fields: ctx, playlistID, uris
+func (_m *PlaylistsService) ReplacePlaylistItems(ctx context.Context, playlistID string, uris ...string) (model.SnapshotID, error) {
+	_va := make([]interface{}, len(uris))
+	for _i := range uris {
+		_va[_i] = uris[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, playlistID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePlaylistItems")
+	}
+
+	var r0 model.SnapshotID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) (model.SnapshotID, error)); ok {
+		return rf(ctx, playlistID, uris...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) model.SnapshotID); ok {
+		r0 = rf(ctx, playlistID, uris...)
+	} else {
+		r0 = ret.Get(0).(model.SnapshotID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(ctx, playlistID, uris...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPlaylistsService creates a new instance of PlaylistsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlaylistsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlaylistsService {
+	mock := &PlaylistsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
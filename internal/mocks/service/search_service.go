@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchService is an autogenerated mock type for the SearchService type
+type SearchService struct {
+	mock.Mock
+}
+
+// Search provides a mock function with given fields: ctx, query, searchTypes, countryMarketName, limit, offset, includeExternalAudio
+func (_m *SearchService) Search(ctx context.Context, query model.SearchQuery, searchTypes []string, countryMarketName *string, limit *int, offset *int, includeExternalAudio bool) (model.SearchResult, error) {
+	ret := _m.Called(ctx, query, searchTypes, countryMarketName, limit, offset, includeExternalAudio)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 model.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchQuery, []string, *string, *int, *int, bool) (model.SearchResult, error)); ok {
+		return rf(ctx, query, searchTypes, countryMarketName, limit, offset, includeExternalAudio)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchQuery, []string, *string, *int, *int, bool) model.SearchResult); ok {
+		r0 = rf(ctx, query, searchTypes, countryMarketName, limit, offset, includeExternalAudio)
+	} else {
+		r0 = ret.Get(0).(model.SearchResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SearchQuery, []string, *string, *int, *int, bool) error); ok {
+		r1 = rf(ctx, query, searchTypes, countryMarketName, limit, offset, includeExternalAudio)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSearchService creates a new instance of SearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchService {
+	mock := &SearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,122 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShowsService is an autogenerated mock type for the ShowsService type
+type ShowsService struct {
+	mock.Mock
+}
+
+// GetShow provides a mock function with given fields: ctx, countryMarketName, showID
+func (_m *ShowsService) GetShow(ctx context.Context, countryMarketName *string, showID string) (model.Show, error) {
+	ret := _m.Called(ctx, countryMarketName, showID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShow")
+	}
+
+	var r0 model.Show
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Show, error)); ok {
+		return rf(ctx, countryMarketName, showID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Show); ok {
+		r0 = rf(ctx, countryMarketName, showID)
+	} else {
+		r0 = ret.Get(0).(model.Show)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, showID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShowEpisodes provides a mock function with given fields: ctx, countryMarketName, limit, offset, showID
+func (_m *ShowsService) GetShowEpisodes(ctx context.Context, countryMarketName *string, limit *int, offset *int, showID string) (model.SimplifiedEpisodesPaginated, error) {
+	ret := _m.Called(ctx, countryMarketName, limit, offset, showID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShowEpisodes")
+	}
+
+	var r0 model.SimplifiedEpisodesPaginated
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int, string) (model.SimplifiedEpisodesPaginated, error)); ok {
+		return rf(ctx, countryMarketName, limit, offset, showID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int, *int, string) model.SimplifiedEpisodesPaginated); ok {
+		r0 = rf(ctx, countryMarketName, limit, offset, showID)
+	} else {
+		r0 = ret.Get(0).(model.SimplifiedEpisodesPaginated)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int, *int, string) error); ok {
+		r1 = rf(ctx, countryMarketName, limit, offset, showID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShows provides a mock function with given fields: ctx, countryMarketName, showsIDs
+func (_m *ShowsService) GetShows(ctx context.Context, countryMarketName *string, showsIDs ...string) ([]model.SimplifiedShow, error) {
+	_va := make([]interface{}, len(showsIDs))
+	for _i := range showsIDs {
+		_va[_i] = showsIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, countryMarketName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShows")
+	}
+
+	var r0 []model.SimplifiedShow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) ([]model.SimplifiedShow, error)); ok {
+		return rf(ctx, countryMarketName, showsIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) []model.SimplifiedShow); ok {
+		r0 = rf(ctx, countryMarketName, showsIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SimplifiedShow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, ...string) error); ok {
+		r1 = rf(ctx, countryMarketName, showsIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewShowsService creates a new instance of ShowsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShowsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShowsService {
+	mock := &ShowsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
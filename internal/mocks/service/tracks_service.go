@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	model "jezz-go-spotify-integration/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ type TracksService struct {
 	mock.Mock
 }
 
-// GetTrack provides a mock function with given fields: countryMarketName, trackID
-func (_m *TracksService) GetTrack(countryMarketName *string, trackID string) (model.Track, error) {
-	ret := _m.Called(countryMarketName, trackID)
+// GetTrack provides a mock function with given fields: ctx, countryMarketName, trackID
+func (_m *TracksService) GetTrack(ctx context.Context, countryMarketName *string, trackID string) (model.Track, error) {
+	ret := _m.Called(ctx, countryMarketName, trackID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTrack")
@@ -23,17 +24,17 @@ func (_m *TracksService) GetTrack(countryMarketName *string, trackID string) (mo
 
 	var r0 model.Track
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, string) (model.Track, error)); ok {
-		return rf(countryMarketName, trackID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) (model.Track, error)); ok {
+		return rf(ctx, countryMarketName, trackID)
 	}
-	if rf, ok := ret.Get(0).(func(*string, string) model.Track); ok {
-		r0 = rf(countryMarketName, trackID)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, string) model.Track); ok {
+		r0 = rf(ctx, countryMarketName, trackID)
 	} else {
 		r0 = ret.Get(0).(model.Track)
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, string) error); ok {
-		r1 = rf(countryMarketName, trackID)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, string) error); ok {
+		r1 = rf(ctx, countryMarketName, trackID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,14 +42,14 @@ func (_m *TracksService) GetTrack(countryMarketName *string, trackID string) (mo
 	return r0, r1
 }
 
-// GetTracks provides a mock function with given fields: countryMarketName, tracksIDs
-func (_m *TracksService) GetTracks(countryMarketName *string, tracksIDs ...string) ([]model.Track, error) {
+// GetTracks provides a mock function with given fields: ctx, countryMarketName, tracksIDs
+func (_m *TracksService) GetTracks(ctx context.Context, countryMarketName *string, tracksIDs ...string) ([]model.Track, error) {
 	_va := make([]interface{}, len(tracksIDs))
 	for _i := range tracksIDs {
 		_va[_i] = tracksIDs[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, countryMarketName)
+	_ca = append(_ca, ctx, countryMarketName)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
@@ -58,19 +59,19 @@ func (_m *TracksService) GetTracks(countryMarketName *string, tracksIDs ...strin
 
 	var r0 []model.Track
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, ...string) ([]model.Track, error)); ok {
-		return rf(countryMarketName, tracksIDs...)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) ([]model.Track, error)); ok {
+		return rf(ctx, countryMarketName, tracksIDs...)
 	}
-	if rf, ok := ret.Get(0).(func(*string, ...string) []model.Track); ok {
-		r0 = rf(countryMarketName, tracksIDs...)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, ...string) []model.Track); ok {
+		r0 = rf(ctx, countryMarketName, tracksIDs...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Track)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, ...string) error); ok {
-		r1 = rf(countryMarketName, tracksIDs...)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, ...string) error); ok {
+		r1 = rf(ctx, countryMarketName, tracksIDs...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FetchBatchFn is an autogenerated mock type for the FetchBatchFn type
+type FetchBatchFn[T interface{}] struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, ids
+func (_m *FetchBatchFn[T]) Execute(ctx context.Context, ids []model.ID) ([]T, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []T
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ID) ([]T, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ID) []T); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]T)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.ID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFetchBatchFn creates a new instance of FetchBatchFn. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFetchBatchFn[T interface{}](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FetchBatchFn[T] {
+	mock := &FetchBatchFn[T]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FetchPageFn is an autogenerated mock type for the FetchPageFn type
+type FetchPageFn[T interface{}] struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: limit, offset
+func (_m *FetchPageFn[T]) Execute(limit model.Limit, offset model.Offset) ([]T, model.Pagination, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []T
+	var r1 model.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(model.Limit, model.Offset) ([]T, model.Pagination, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(model.Limit, model.Offset) []T); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]T)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Limit, model.Offset) model.Pagination); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Get(1).(model.Pagination)
+	}
+
+	if rf, ok := ret.Get(2).(func(model.Limit, model.Offset) error); ok {
+		r2 = rf(limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewFetchPageFn creates a new instance of FetchPageFn. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFetchPageFn[T interface{}](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FetchPageFn[T] {
+	mock := &FetchPageFn[T]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
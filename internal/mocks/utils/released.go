@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "jezz-go-spotify-integration/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Released is an autogenerated mock type for the Released type
+type Released struct {
+	mock.Mock
+}
+
+// ReleasedOn provides a mock function with no fields
+func (_m *Released) ReleasedOn() model.ReleaseDate {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleasedOn")
+	}
+
+	var r0 model.ReleaseDate
+	if rf, ok := ret.Get(0).(func() model.ReleaseDate); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.ReleaseDate)
+	}
+
+	return r0
+}
+
+// NewReleased creates a new instance of Released. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReleased(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Released {
+	mock := &Released{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
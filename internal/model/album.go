@@ -18,6 +18,13 @@ type AlbumType string
 
 type AlbumGroup string
 
+const (
+	AlbumGroupAlbum       AlbumGroup = "album"
+	AlbumGroupSingle      AlbumGroup = "single"
+	AlbumGroupAppearsOn   AlbumGroup = "appears_on"
+	AlbumGroupCompilation AlbumGroup = "compilation"
+)
+
 func (a AlbumGroup) String() string {
 	return string(a)
 }
@@ -31,27 +38,28 @@ func (a AlbumGroups) String() string {
 }
 
 type SimplifiedAlbum struct {
-	AlbumType            AlbumType          `json:"album_type"`
-	TotalTracks          int                `json:"total_tracks"`
-	AvailableMarkets     []AvailableMarket  `json:"available_markets"`
-	ExternalURLs         ExternalURLs       `json:"external_ur_ls"`
-	Href                 Href               `json:"href"`
-	ID                   ID                 `json:"id"`
-	Images               []Image            `json:"images"`
-	Name                 Name               `json:"name"`
-	ReleaseDate          string             `json:"release_date"`
-	ReleaseDatePrecision string             `json:"release_date_precision"`
-	Restrictions         Restrictions       `json:"restrictions"`
-	Type                 Type               `json:"type"`
-	URI                  URI                `json:"uri"`
-	Artists              []SimplifiedArtist `json:"artists"`
+	AlbumType            AlbumType            `json:"album_type"`
+	TotalTracks          int                  `json:"total_tracks"`
+	AvailableMarkets     []AvailableMarket    `json:"available_markets"`
+	ExternalURLs         ExternalURLs         `json:"external_urls"`
+	Href                 Href                 `json:"href"`
+	ID                   ID                   `json:"id"`
+	Images               []Image              `json:"images"`
+	Name                 Name                 `json:"name"`
+	ReleaseDate          ReleaseDate          `json:"release_date"`
+	ReleaseDatePrecision ReleaseDatePrecision `json:"release_date_precision"`
+	Restrictions         Restrictions         `json:"restrictions"`
+	Type                 Type                 `json:"type"`
+	URI                  URI                  `json:"uri"`
+	Artists              []SimplifiedArtist   `json:"artists"`
 }
 
 type Album struct {
 	SimplifiedAlbum
 	Tracks      SimplifiedTracksPaginated `json:"tracks"`
 	Copyrights  []Copyright               `json:"copyrights"`
-	ExternalIDs ExternalIDs               `json:"external_i_ds"`
+	ExternalIDs ExternalIDs               `json:"external_ids"`
+	Genres      Genres                    `json:"genres"`
 	Label       string                    `json:"label"`
 	Popularity  int                       `json:"popularity"`
 }
@@ -68,3 +76,8 @@ type SimplifiedAlbumsPaginated struct {
 type AlbumsNewRelease struct {
 	Albums SimplifiedAlbumsPaginated `json:"albums"`
 }
+
+// ReleasedOn answers the release date, for the helpers sorting and filtering by it
+func (a SimplifiedAlbum) ReleasedOn() ReleaseDate {
+	return a.ReleaseDate
+}
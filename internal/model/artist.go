@@ -15,7 +15,7 @@ func (a ArtistsIDs) String() string {
 }
 
 type SimplifiedArtist struct {
-	ExternalURLs ExternalURLs `json:"external_ur_ls"`
+	ExternalURLs ExternalURLs `json:"external_urls"`
 	Href         Href         `json:"href"`
 	ID           ID           `json:"id"`
 	Name         Name         `json:"name"`
@@ -44,3 +44,8 @@ type SimplifiedArtistAlbumsPaginated struct {
 	Pagination
 	Items []SimplifiedArtistAlbum `json:"items"`
 }
+
+type ArtistsPaginated struct {
+	Pagination
+	Items []Artist `json:"items"`
+}
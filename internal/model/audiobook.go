@@ -0,0 +1,54 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type AudiobooksIDs []ID
+
+func (a AudiobooksIDs) String() string {
+	return strings.Join(lo.Map(a, func(audiobookID ID, _ int) string {
+		return audiobookID.String()
+	}), ",")
+}
+
+type Author struct {
+	Name string `json:"name"`
+}
+
+type Narrator struct {
+	Name string `json:"name"`
+}
+
+type SimplifiedAudiobook struct {
+	Authors          []Author          `json:"authors"`
+	AvailableMarkets []AvailableMarket `json:"available_markets"`
+	Copyrights       []Copyright       `json:"copyrights"`
+	Description      string            `json:"description"`
+	HTMLDescription  string            `json:"html_description"`
+	Edition          string            `json:"edition"`
+	Explicit         bool              `json:"explicit"`
+	ExternalURLs     ExternalURLs      `json:"external_urls"`
+	Href             Href              `json:"href"`
+	ID               ID                `json:"id"`
+	Images           []Image           `json:"images"`
+	Languages        []string          `json:"languages"`
+	MediaType        string            `json:"media_type"`
+	Name             Name              `json:"name"`
+	Narrators        []Narrator        `json:"narrators"`
+	Publisher        string            `json:"publisher"`
+	Type             Type              `json:"type"`
+	URI              URI               `json:"uri"`
+	TotalChapters    int               `json:"total_chapters"`
+}
+
+type Audiobook struct {
+	SimplifiedAudiobook
+	Chapters SimplifiedChaptersPaginated `json:"chapters"`
+}
+
+type MultipleAudiobooks struct {
+	Audiobooks []Audiobook `json:"audiobooks"`
+}
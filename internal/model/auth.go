@@ -1,13 +1,66 @@
 package model
 
+import (
+	"strings"
+	"time"
+)
+
 type AccessToken string
 
 func (t AccessToken) String() string {
 	return string(t)
 }
 
+type RefreshToken string
+
+func (t RefreshToken) String() string {
+	return string(t)
+}
+
 type Authentication struct {
-	AccessToken AccessToken `json:"access_token"`
-	TokenType   string      `json:"token_type"`
-	ExpiresIn   int         `json:"expires_in"`
+	AccessToken  AccessToken  `json:"access_token"`
+	TokenType    string       `json:"token_type"`
+	ExpiresIn    int          `json:"expires_in"`
+	RefreshToken RefreshToken `json:"refresh_token,omitempty"`
+	Scope        string       `json:"scope,omitempty"`
+}
+
+// UserSession is the persisted form of a user-delegated Authentication, with an absolute expiry instead of a lifetime.
+type UserSession struct {
+	AccessToken  AccessToken  `json:"access_token"`
+	TokenType    string       `json:"token_type"`
+	RefreshToken RefreshToken `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	Scopes       []string     `json:"scopes,omitempty"`
+	// RequestedScopes are the scopes configured when the session was obtained, to tell when they have changed
+	RequestedScopes []string `json:"requested_scopes,omitempty"`
+}
+
+func NewUserSession(authentication Authentication, obtainedAt time.Time) UserSession {
+	var scopes []string
+	if authentication.Scope != "" {
+		scopes = strings.Fields(authentication.Scope)
+	}
+	return UserSession{
+		AccessToken:  authentication.AccessToken,
+		TokenType:    authentication.TokenType,
+		RefreshToken: authentication.RefreshToken,
+		ExpiresAt:    obtainedAt.Add(time.Duration(authentication.ExpiresIn) * time.Second),
+		Scopes:       scopes,
+	}
+}
+
+func (s UserSession) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// Authentication converts the session back, reporting as lifetime the time left until it expires at now.
+func (s UserSession) Authentication(now time.Time) Authentication {
+	return Authentication{
+		AccessToken:  s.AccessToken,
+		TokenType:    s.TokenType,
+		ExpiresIn:    max(int(s.ExpiresAt.Sub(now).Seconds()), 0),
+		RefreshToken: s.RefreshToken,
+		Scope:        strings.Join(s.Scopes, " "),
+	}
 }
@@ -5,3 +5,7 @@ type AvailableMarket string
 func (m AvailableMarket) String() string {
 	return string(m)
 }
+
+type AvailableMarkets struct {
+	Markets []AvailableMarket `json:"markets"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// Locale is an ISO 639-1 language code and an ISO 3166-1 alpha-2 country code joined by an underscore, e.g. "es_MX".
+type Locale string
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+type Category struct {
+	Href  Href    `json:"href"`
+	Icons []Image `json:"icons"`
+	ID    ID      `json:"id"`
+	Name  Name    `json:"name"`
+}
+
+type CategoriesPaginated struct {
+	Pagination
+	Items []Category `json:"items"`
+}
+
+type MultipleCategories struct {
+	Categories CategoriesPaginated `json:"categories"`
+}
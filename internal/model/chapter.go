@@ -0,0 +1,61 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type ChaptersIDs []ID
+
+func (a ChaptersIDs) String() string {
+	return strings.Join(lo.Map(a, func(chapterID ID, _ int) string {
+		return chapterID.String()
+	}), ",")
+}
+
+type SimplifiedChapter struct {
+	AudioPreviewURL      *URL                 `json:"audio_preview_url"`
+	AvailableMarkets     []AvailableMarket    `json:"available_markets"`
+	ChapterNumber        int                  `json:"chapter_number"`
+	Description          string               `json:"description"`
+	HTMLDescription      string               `json:"html_description"`
+	DurationMs           int                  `json:"duration_ms"`
+	Explicit             bool                 `json:"explicit"`
+	ExternalURLs         ExternalURLs         `json:"external_urls"`
+	Href                 Href                 `json:"href"`
+	ID                   ID                   `json:"id"`
+	Images               []Image              `json:"images"`
+	IsPlayable           bool                 `json:"is_playable"`
+	Languages            []string             `json:"languages"`
+	Name                 Name                 `json:"name"`
+	ReleaseDate          ReleaseDate          `json:"release_date"`
+	ReleaseDatePrecision ReleaseDatePrecision `json:"release_date_precision"`
+	ResumePoint          *ResumePoint         `json:"resume_point,omitempty"`
+	Type                 Type                 `json:"type"`
+	URI                  URI                  `json:"uri"`
+	Restrictions         Restrictions         `json:"restrictions"`
+}
+
+type Chapter struct {
+	SimplifiedChapter
+	Audiobook SimplifiedAudiobook `json:"audiobook"`
+}
+
+type MultipleChapters struct {
+	Chapters []Chapter `json:"chapters"`
+}
+
+type SimplifiedChaptersPaginated struct {
+	Pagination
+	Items []SimplifiedChapter `json:"items"`
+}
+
+func (c SimplifiedChapter) ReleasedOn() ReleaseDate {
+	return c.ReleaseDate
+}
+
+func (c SimplifiedChapter) Duration() time.Duration {
+	return time.Duration(c.DurationMs) * time.Millisecond
+}
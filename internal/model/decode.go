@@ -0,0 +1,22 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalStrict decodes data like json.Unmarshal but fails on fields the target doesn't map, so fields Spotify
+// added or renamed are reported instead of silently dropped. Types decoding themselves, like PlaylistItemContent,
+// are not checked.
+func UnmarshalStrict(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after top-level value")
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const apiResponsesDir = "../../test/data/api-responses"
+
+var update = flag.Bool("update", false, "update the golden files with the decoded responses")
+
+// TestUnmarshalStrict_RecordedResponses decodes responses recorded from the API strictly and compares what was
+// decoded against the golden files, so fields which are not mapped, or mapped to the wrong tag, show up in CI.
+// Run with -update to regenerate the golden files after changing the model.
+func TestUnmarshalStrict_RecordedResponses(t *testing.T) {
+	tests := []struct {
+		file   string
+		target func() any
+	}{
+		{file: "album.json", target: func() any { return &Album{} }},
+		{file: "albums.json", target: func() any { return &MultipleAlbums{} }},
+		{file: "album_tracks.json", target: func() any { return &SimplifiedTracksPaginated{} }},
+		{file: "new_releases.json", target: func() any { return &AlbumsNewRelease{} }},
+		{file: "artist.json", target: func() any { return &Artist{} }},
+		{file: "artists.json", target: func() any { return &MultipleArtists{} }},
+		{file: "artist_albums.json", target: func() any { return &SimplifiedArtistAlbumsPaginated{} }},
+		{file: "artist_top_tracks.json", target: func() any { return &MultipleTracks{} }},
+		{file: "track.json", target: func() any { return &Track{} }},
+		{file: "tracks.json", target: func() any { return &MultipleTracks{} }},
+		{file: "search.json", target: func() any { return &SearchResult{} }},
+		{file: "playlist.json", target: func() any { return &Playlist{} }},
+		{file: "playlist_items.json", target: func() any { return &PlaylistItemsPaginated{} }},
+		{file: "playlist_cover_image.json", target: func() any { return &[]Image{} }},
+		{file: "playlist_snapshot.json", target: func() any { return &PlaylistSnapshot{} }},
+		{file: "show.json", target: func() any { return &Show{} }},
+		{file: "shows.json", target: func() any { return &MultipleShows{} }},
+		{file: "show_episodes.json", target: func() any { return &SimplifiedEpisodesPaginated{} }},
+		{file: "episode.json", target: func() any { return &Episode{} }},
+		{file: "episodes.json", target: func() any { return &MultipleEpisodes{} }},
+		{file: "audiobook.json", target: func() any { return &Audiobook{} }},
+		{file: "audiobooks.json", target: func() any { return &MultipleAudiobooks{} }},
+		{file: "audiobook_chapters.json", target: func() any { return &SimplifiedChaptersPaginated{} }},
+		{file: "chapter.json", target: func() any { return &Chapter{} }},
+		{file: "chapters.json", target: func() any { return &MultipleChapters{} }},
+		{file: "categories.json", target: func() any { return &MultipleCategories{} }},
+		{file: "category.json", target: func() any { return &Category{} }},
+		{file: "markets.json", target: func() any { return &AvailableMarkets{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(apiResponsesDir, "recorded", tt.file))
+			if err != nil {
+				t.Fatalf("error reading recorded response - %v", err)
+			}
+			target := tt.target()
+			if err = UnmarshalStrict(data, target); err != nil {
+				t.Fatalf("UnmarshalStrict() error = %v", err)
+			}
+			got, err := json.MarshalIndent(target, "", "  ")
+			if err != nil {
+				t.Fatalf("error encoding decoded response - %v", err)
+			}
+			got = append(got, '\n')
+
+			goldenPath := filepath.Join(apiResponsesDir, "golden", tt.file)
+			if *update {
+				if err = os.WriteFile(goldenPath, got, 0o644); err != nil {
+					t.Fatalf("error updating golden file - %v", err)
+				}
+			}
+			want, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("error reading golden file - %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("decoded %s differs from %s, run with -update if the change is expected\ngot:\n%s", tt.file, goldenPath, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "should decode mapped fields", data: `{"external_urls":{"spotify":"https://open.spotify.com/artist/1"},"id":"1"}`},
+		{name: "should return error on unknown fields", data: `{"id":"1","genre":"pop"}`, wantErr: true},
+		{name: "should return error on renamed fields", data: `{"external_ur_ls":{"spotify":"https://open.spotify.com/artist/1"}}`, wantErr: true},
+		{name: "should return error on unknown nested fields", data: `{"external_urls":{"apple":"https://music.apple.com"}}`, wantErr: true},
+		{name: "should return error on trailing data", data: `{"id":"1"}{"id":"2"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var artist SimplifiedArtist
+			if err := UnmarshalStrict([]byte(tt.data), &artist); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalStrict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
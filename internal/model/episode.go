@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type EpisodesIDs []ID
+
+func (a EpisodesIDs) String() string {
+	return strings.Join(lo.Map(a, func(episodeID ID, _ int) string {
+		return episodeID.String()
+	}), ",")
+}
+
+type ResumePoint struct {
+	FullyPlayed      bool `json:"fully_played"`
+	ResumePositionMs int  `json:"resume_position_ms"`
+}
+
+type SimplifiedEpisode struct {
+	AudioPreviewURL      *URL                 `json:"audio_preview_url"`
+	Description          string               `json:"description"`
+	HTMLDescription      string               `json:"html_description"`
+	DurationMs           int                  `json:"duration_ms"`
+	Explicit             bool                 `json:"explicit"`
+	ExternalURLs         ExternalURLs         `json:"external_urls"`
+	Href                 Href                 `json:"href"`
+	ID                   ID                   `json:"id"`
+	Images               []Image              `json:"images"`
+	IsExternallyHosted   bool                 `json:"is_externally_hosted"`
+	IsPlayable           bool                 `json:"is_playable"`
+	Language             string               `json:"language"`
+	Languages            []string             `json:"languages"`
+	Name                 Name                 `json:"name"`
+	ReleaseDate          ReleaseDate          `json:"release_date"`
+	ReleaseDatePrecision ReleaseDatePrecision `json:"release_date_precision"`
+	ResumePoint          *ResumePoint         `json:"resume_point,omitempty"`
+	Type                 Type                 `json:"type"`
+	URI                  URI                  `json:"uri"`
+	Restrictions         Restrictions         `json:"restrictions"`
+}
+
+type SimplifiedEpisodesPaginated struct {
+	Pagination
+	Items []SimplifiedEpisode `json:"items"`
+}
+
+type Episode struct {
+	SimplifiedEpisode
+	Show SimplifiedShow `json:"show"`
+}
+
+type MultipleEpisodes struct {
+	Episodes []Episode `json:"episodes"`
+}
+
+func (e SimplifiedEpisode) ReleasedOn() ReleaseDate {
+	return e.ReleaseDate
+}
+
+func (e SimplifiedEpisode) Duration() time.Duration {
+	return time.Duration(e.DurationMs) * time.Millisecond
+}
@@ -1,7 +1,10 @@
 package model
 
 const (
-	HTTPGet HTTPMethod = "GET"
+	HTTPGet    HTTPMethod = "GET"
+	HTTPPost   HTTPMethod = "POST"
+	HTTPPut    HTTPMethod = "PUT"
+	HTTPDelete HTTPMethod = "DELETE"
 )
 
 type HTTPMethod string
@@ -1,7 +1,7 @@
 package model
 
 type LinkedFrom struct {
-	ExternalURLs ExternalURLs `json:"external_ur_ls"`
+	ExternalURLs ExternalURLs `json:"external_urls"`
 	Href         Href         `json:"href"`
 	ID           string       `json:"id"`
 	Type         string       `json:"type"`
@@ -0,0 +1,190 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type SnapshotID string
+
+func (s SnapshotID) String() string {
+	return string(s)
+}
+
+type PlaylistOwner struct {
+	ExternalURLs ExternalURLs `json:"external_urls"`
+	Href         Href         `json:"href"`
+	ID           ID           `json:"id"`
+	Type         Type         `json:"type"`
+	URI          URI          `json:"uri"`
+	DisplayName  *string      `json:"display_name"`
+}
+
+type PlaylistTracksReference struct {
+	Href  Href `json:"href"`
+	Total int  `json:"total"`
+}
+
+type SimplifiedPlaylist struct {
+	Collaborative bool                    `json:"collaborative"`
+	Description   string                  `json:"description"`
+	ExternalURLs  ExternalURLs            `json:"external_urls"`
+	Href          Href                    `json:"href"`
+	ID            ID                      `json:"id"`
+	Images        []Image                 `json:"images"`
+	Name          Name                    `json:"name"`
+	Owner         PlaylistOwner           `json:"owner"`
+	Public        *bool                   `json:"public"`
+	SnapshotID    SnapshotID              `json:"snapshot_id"`
+	Tracks        PlaylistTracksReference `json:"tracks"`
+	Type          Type                    `json:"type"`
+	URI           URI                     `json:"uri"`
+}
+
+type SimplifiedPlaylistsPaginated struct {
+	Pagination
+	Items []SimplifiedPlaylist `json:"items"`
+}
+
+type Playlist struct {
+	Collaborative bool                   `json:"collaborative"`
+	Description   string                 `json:"description"`
+	ExternalURLs  ExternalURLs           `json:"external_urls"`
+	Followers     Followers              `json:"followers"`
+	Href          Href                   `json:"href"`
+	ID            ID                     `json:"id"`
+	Images        []Image                `json:"images"`
+	Name          Name                   `json:"name"`
+	Owner         PlaylistOwner          `json:"owner"`
+	Public        *bool                  `json:"public"`
+	SnapshotID    SnapshotID             `json:"snapshot_id"`
+	Tracks        PlaylistItemsPaginated `json:"tracks"`
+	Type          Type                   `json:"type"`
+	URI           URI                    `json:"uri"`
+}
+
+type PlaylistItem struct {
+	AddedAt *string             `json:"added_at"`
+	AddedBy *PlaylistOwner      `json:"added_by"`
+	IsLocal bool                `json:"is_local"`
+	Track   PlaylistItemContent `json:"track"`
+}
+
+type PlaylistItemsPaginated struct {
+	Pagination
+	Items []PlaylistItem `json:"items"`
+}
+
+const (
+	PlaylistItemTypeTrack   Type = "track"
+	PlaylistItemTypeEpisode Type = "episode"
+)
+
+// PlaylistItemContent is the track or episode of a playlist item, decoded according to its type.
+// Items without a type, e.g. when the fields filter leaves it out, are decoded as tracks. Items of any other
+// type only keep their type, and removed items, answered as null, keep everything empty.
+type PlaylistItemContent struct {
+	Type    Type
+	Track   *Track
+	Episode *Episode
+}
+
+func (c *PlaylistItemContent) UnmarshalJSON(data []byte) error {
+	*c = PlaylistItemContent{}
+	if string(data) == "null" {
+		return nil
+	}
+
+	var discriminator struct {
+		Type Type `json:"type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return fmt.Errorf("error decoding playlist item - %w", err)
+	}
+	c.Type = discriminator.Type
+
+	switch discriminator.Type {
+	case PlaylistItemTypeTrack, "":
+		c.Track = &Track{}
+		return json.Unmarshal(data, c.Track)
+	case PlaylistItemTypeEpisode:
+		c.Episode = &Episode{}
+		return json.Unmarshal(data, c.Episode)
+	}
+	return nil
+}
+
+func (c PlaylistItemContent) MarshalJSON() ([]byte, error) {
+	switch {
+	case c.Track != nil:
+		return json.Marshal(c.Track)
+	case c.Episode != nil:
+		return json.Marshal(c.Episode)
+	case c.Type != "":
+		return json.Marshal(map[string]Type{"type": c.Type})
+	}
+	return []byte("null"), nil
+}
+
+// Fields selects the fields answered by the playlist endpoints, e.g. "items(added_at,track(name,href))".
+type Fields string
+
+func (f Fields) String() string {
+	return string(f)
+}
+
+type AdditionalType string
+
+func (a AdditionalType) String() string {
+	return string(a)
+}
+
+type AdditionalTypes []AdditionalType
+
+func (a AdditionalTypes) String() string {
+	return strings.Join(lo.Map(a, func(additionalType AdditionalType, _ int) string {
+		return additionalType.String()
+	}), ",")
+}
+
+// PlaylistDetails holds the playlist attributes that can be set when creating or changing a playlist;
+// nil attributes are left untouched.
+type PlaylistDetails struct {
+	Name          *string `json:"name,omitempty"`
+	Public        *bool   `json:"public,omitempty"`
+	Collaborative *bool   `json:"collaborative,omitempty"`
+	Description   *string `json:"description,omitempty"`
+}
+
+type PlaylistSnapshot struct {
+	SnapshotID SnapshotID `json:"snapshot_id"`
+}
+
+type AddPlaylistItemsRequest struct {
+	URIs     []URI `json:"uris"`
+	Position *int  `json:"position,omitempty"`
+}
+
+type ReplacePlaylistItemsRequest struct {
+	URIs []URI `json:"uris"`
+}
+
+// ReorderPlaylistItemsRequest moves the RangeLength items starting at RangeStart to before the item at InsertBefore.
+type ReorderPlaylistItemsRequest struct {
+	RangeStart   int         `json:"range_start"`
+	InsertBefore int         `json:"insert_before"`
+	RangeLength  *int        `json:"range_length,omitempty"`
+	SnapshotID   *SnapshotID `json:"snapshot_id,omitempty"`
+}
+
+type PlaylistItemURI struct {
+	URI URI `json:"uri"`
+}
+
+type RemovePlaylistItemsRequest struct {
+	Tracks     []PlaylistItemURI `json:"tracks"`
+	SnapshotID *SnapshotID       `json:"snapshot_id,omitempty"`
+}
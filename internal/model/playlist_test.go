@@ -0,0 +1,91 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPlaylistItemContent_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantType    Type
+		wantTrack   ID
+		wantEpisode ID
+		wantErr     bool
+	}{
+		{
+			name:      "should decode tracks",
+			data:      `{"type":"track","id":"track-1","album":{"id":"album-1"}}`,
+			wantType:  PlaylistItemTypeTrack,
+			wantTrack: "track-1",
+		},
+		{
+			name:        "should decode episodes",
+			data:        `{"type":"episode","id":"episode-1","show":{"id":"show-1"}}`,
+			wantType:    PlaylistItemTypeEpisode,
+			wantEpisode: "episode-1",
+		},
+		{
+			name:      "should decode items without type as tracks",
+			data:      `{"id":"track-2"}`,
+			wantTrack: "track-2",
+		},
+		{
+			name: "should keep removed items empty",
+			data: `null`,
+		},
+		{
+			name:     "should keep only the type of unknown items",
+			data:     `{"type":"audiobook","id":"audiobook-1"}`,
+			wantType: "audiobook",
+		},
+		{
+			name:    "should return error on invalid items",
+			data:    `["track"]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got PlaylistItemContent
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Type != tt.wantType {
+				t.Errorf("UnmarshalJSON() type = %q, want %q", got.Type, tt.wantType)
+			}
+			if (got.Track != nil) != (tt.wantTrack != "") || (got.Track != nil && got.Track.ID != tt.wantTrack) {
+				t.Errorf("UnmarshalJSON() track = %+v, want id %q", got.Track, tt.wantTrack)
+			}
+			if (got.Episode != nil) != (tt.wantEpisode != "") || (got.Episode != nil && got.Episode.ID != tt.wantEpisode) {
+				t.Errorf("UnmarshalJSON() episode = %+v, want id %q", got.Episode, tt.wantEpisode)
+			}
+		})
+	}
+}
+
+func TestPlaylistItemsPaginated_RoundTrip(t *testing.T) {
+	data := `{"items":[{"added_at":"2024-01-01T00:00:00Z","is_local":false,"track":{"type":"episode","id":"episode-1","show":{"id":"show-1"}}},` +
+		`{"is_local":false,"track":{"type":"track","id":"track-1"}},{"is_local":false,"track":null}],"total":3}`
+	var page PlaylistItemsPaginated
+	if err := json.Unmarshal([]byte(data), &page); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if page.Items[0].Track.Episode.Show.ID != "show-1" || page.Items[1].Track.Track.ID != "track-1" || page.Items[2].Track.Type != "" {
+		t.Fatalf("json.Unmarshal() = %+v", page)
+	}
+
+	encoded, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decodedAgain PlaylistItemsPaginated
+	if err = json.Unmarshal(encoded, &decodedAgain); err != nil {
+		t.Fatalf("json.Unmarshal() of encoded page error = %v", err)
+	}
+	if decodedAgain.Items[0].Track.Episode == nil || decodedAgain.Items[1].Track.Track == nil || decodedAgain.Items[2].Track.Track != nil {
+		t.Errorf("round trip changed items to %+v", decodedAgain.Items)
+	}
+}
@@ -0,0 +1,45 @@
+package model
+
+import "fmt"
+
+const (
+	URIScheme     = "spotify"
+	OpenURLPrefix = "https://open.spotify.com/"
+)
+
+type EntityType string
+
+func (e EntityType) String() string {
+	return string(e)
+}
+
+const (
+	EntityTypeAlbum     EntityType = "album"
+	EntityTypeArtist    EntityType = "artist"
+	EntityTypeTrack     EntityType = "track"
+	EntityTypePlaylist  EntityType = "playlist"
+	EntityTypeShow      EntityType = "show"
+	EntityTypeEpisode   EntityType = "episode"
+	EntityTypeAudiobook EntityType = "audiobook"
+	EntityTypeChapter   EntityType = "chapter"
+)
+
+// Reference points to a catalog entity, as written in Spotify URIs and open.spotify.com share links.
+type Reference struct {
+	Type EntityType
+	ID   ID
+}
+
+// URI formats the reference as a Spotify URI, e.g. "spotify:track:11dFghVXANMlKmJXsNCbNl".
+func (r Reference) URI() URI {
+	return URI(fmt.Sprintf("%s:%s:%s", URIScheme, r.Type, r.ID))
+}
+
+// URL formats the reference as a share link, e.g. "https://open.spotify.com/track/11dFghVXANMlKmJXsNCbNl".
+func (r Reference) URL() URL {
+	return URL(OpenURLPrefix + r.Type.String() + "/" + r.ID.String())
+}
+
+func (r Reference) String() string {
+	return string(r.URI())
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type ReleaseDatePrecision string
+
+const (
+	ReleaseDatePrecisionYear  ReleaseDatePrecision = "year"
+	ReleaseDatePrecisionMonth ReleaseDatePrecision = "month"
+	ReleaseDatePrecisionDay   ReleaseDatePrecision = "day"
+)
+
+var releaseDateLayouts = map[ReleaseDatePrecision]string{
+	ReleaseDatePrecisionYear:  "2006",
+	ReleaseDatePrecisionMonth: "2006-01",
+	ReleaseDatePrecisionDay:   "2006-01-02",
+}
+
+// rank orders the precisions from the least to the most precise
+func (p ReleaseDatePrecision) rank() int {
+	switch p {
+	case ReleaseDatePrecisionYear:
+		return 1
+	case ReleaseDatePrecisionMonth:
+		return 2
+	case ReleaseDatePrecisionDay:
+		return 3
+	}
+	return 0
+}
+
+// ReleaseDate is a release date known to the year, the month or the day, as answered by the API,
+// e.g. "1981", "1981-12" or "1981-12-15". The zero value is an unknown release date. Dates the API answers in
+// any other form, e.g. "0000-00-00", are unknown too, but keep their text.
+type ReleaseDate struct {
+	date      time.Time
+	precision ReleaseDatePrecision
+	raw       string
+}
+
+func NewReleaseDate(year int, month time.Month, day int) ReleaseDate {
+	switch {
+	case month == 0:
+		return ReleaseDate{date: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), precision: ReleaseDatePrecisionYear}
+	case day == 0:
+		return ReleaseDate{date: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), precision: ReleaseDatePrecisionMonth}
+	}
+	return ReleaseDate{date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), precision: ReleaseDatePrecisionDay}
+}
+
+// ParseReleaseDate parses a release date, its precision given by its format
+func ParseReleaseDate(value string) (ReleaseDate, error) {
+	if value == "" {
+		return ReleaseDate{}, nil
+	}
+	for _, precision := range []ReleaseDatePrecision{ReleaseDatePrecisionDay, ReleaseDatePrecisionMonth, ReleaseDatePrecisionYear} {
+		layout := releaseDateLayouts[precision]
+		if len(value) != len(layout) {
+			continue
+		}
+		date, err := time.Parse(layout, value)
+		if err != nil {
+			return ReleaseDate{}, fmt.Errorf("invalid release date %q - %w", value, err)
+		}
+		return ReleaseDate{date: date, precision: precision}, nil
+	}
+	return ReleaseDate{}, fmt.Errorf("invalid release date %q - expected a year, a year-month or a year-month-day", value)
+}
+
+func (d ReleaseDate) Precision() ReleaseDatePrecision {
+	return d.precision
+}
+
+func (d ReleaseDate) IsZero() bool {
+	return d.precision == ""
+}
+
+// Time answers the first day of the release date, e.g. 1981-01-01 for "1981"
+func (d ReleaseDate) Time() time.Time {
+	return d.date
+}
+
+// End answers the day after the last day of the release date, e.g. 1982-01-01 for "1981"
+func (d ReleaseDate) End() time.Time {
+	switch d.precision {
+	case ReleaseDatePrecisionYear:
+		return d.date.AddDate(1, 0, 0)
+	case ReleaseDatePrecisionMonth:
+		return d.date.AddDate(0, 1, 0)
+	}
+	return d.date.AddDate(0, 0, 1)
+}
+
+// Compare orders release dates by their first day, less precise ones first when they start on the same day and
+// unknown ones before any other.
+func (d ReleaseDate) Compare(other ReleaseDate) int {
+	switch {
+	case d.IsZero() && other.IsZero():
+		return 0
+	case d.IsZero():
+		return -1
+	case other.IsZero():
+		return 1
+	}
+	if c := d.date.Compare(other.date); c != 0 {
+		return c
+	}
+	return cmp.Compare(d.precision.rank(), other.precision.rank())
+}
+
+func (d ReleaseDate) Before(other ReleaseDate) bool {
+	return d.Compare(other) < 0
+}
+
+func (d ReleaseDate) After(other ReleaseDate) bool {
+	return d.Compare(other) > 0
+}
+
+func (d ReleaseDate) String() string {
+	if d.IsZero() {
+		return d.raw
+	}
+	return d.date.Format(releaseDateLayouts[d.precision])
+}
+
+func (d ReleaseDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *ReleaseDate) UnmarshalJSON(data []byte) error {
+	var value *string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == nil {
+		*d = ReleaseDate{}
+		return nil
+	}
+	parsed, err := ParseReleaseDate(*value)
+	if err != nil {
+		// one odd date must not fail the whole response it comes in
+		*d = ReleaseDate{raw: *value}
+		return nil
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseReleaseDate(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		want          ReleaseDate
+		wantPrecision ReleaseDatePrecision
+		wantErr       bool
+	}{
+		{name: "should parse years", value: "1981", want: NewReleaseDate(1981, 0, 0), wantPrecision: ReleaseDatePrecisionYear},
+		{name: "should parse months", value: "1981-12", want: NewReleaseDate(1981, time.December, 0), wantPrecision: ReleaseDatePrecisionMonth},
+		{name: "should parse days", value: "1981-12-15", want: NewReleaseDate(1981, time.December, 15), wantPrecision: ReleaseDatePrecisionDay},
+		{name: "should parse unknown dates as zero", value: ""},
+		{name: "should return error on invalid days", value: "1981-02-30", wantErr: true},
+		{name: "should return error on other formats", value: "15/12/1981", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReleaseDate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReleaseDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want || got.Precision() != tt.wantPrecision {
+				t.Errorf("ParseReleaseDate() = %v (%s), want %v (%s)", got, got.Precision(), tt.want, tt.wantPrecision)
+			}
+			if !tt.wantErr && got.String() != tt.value {
+				t.Errorf("String() = %q, want %q", got.String(), tt.value)
+			}
+		})
+	}
+}
+
+func TestReleaseDate_Compare(t *testing.T) {
+	tests := []struct {
+		name  string
+		date  string
+		other string
+		want  int
+	}{
+		{name: "should order by date", date: "1981-12-15", other: "1982", want: -1},
+		{name: "should order less precise dates first on the same day", date: "1981", other: "1981-01-01", want: -1},
+		{name: "should compare equal dates", date: "1981-12", other: "1981-12", want: 0},
+		{name: "should order unknown dates first", date: "", other: "0001", want: -1},
+		{name: "should order known dates after unknown ones", date: "1981-12-15", other: "", want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, _ := ParseReleaseDate(tt.date)
+			other, _ := ParseReleaseDate(tt.other)
+			if got := date.Compare(other); got != tt.want {
+				t.Errorf("Compare() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseDate_JSON(t *testing.T) {
+	var album SimplifiedAlbum
+	data := `{"release_date":"1981-12","release_date_precision":"month"}`
+	if err := json.Unmarshal([]byte(data), &album); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if want := NewReleaseDate(1981, time.December, 0); album.ReleaseDate != want || album.ReleaseDatePrecision != ReleaseDatePrecisionMonth {
+		t.Errorf("json.Unmarshal() release date = %v (%s), want %v", album.ReleaseDate, album.ReleaseDatePrecision, want)
+	}
+	encoded, err := json.Marshal(album.ReleaseDate)
+	if err != nil || string(encoded) != `"1981-12"` {
+		t.Errorf("json.Marshal() = %s, %v, want \"1981-12\"", encoded, err)
+	}
+
+	var date ReleaseDate
+	if err = json.Unmarshal([]byte(`null`), &date); err != nil || !date.IsZero() {
+		t.Errorf("json.Unmarshal() of null = %v, %v, want zero release date", date, err)
+	}
+	if err = json.Unmarshal([]byte(`"soon"`), &date); err != nil || !date.IsZero() || date.String() != "soon" {
+		t.Errorf("json.Unmarshal() of invalid release date = %v, %v, want unknown release date keeping its text", date, err)
+	}
+}
+
+func TestReleaseDate_JSON_InvalidDateInResponse(t *testing.T) {
+	data := `{"items":[{"id":"album-1","release_date":"0000-00-00","release_date_precision":"day"},` +
+		`{"id":"album-2","release_date":"1981-12-15","release_date_precision":"day"}]}`
+	var page struct {
+		Items []SimplifiedAlbum `json:"items"`
+	}
+
+	if err := json.Unmarshal([]byte(data), &page); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, want the page decoded", err)
+	}
+	if len(page.Items) != 2 || !page.Items[0].ReleaseDate.IsZero() || page.Items[1].ReleaseDate != NewReleaseDate(1981, time.December, 15) {
+		t.Fatalf("json.Unmarshal() = %+v, want the invalid release date unknown and the other parsed", page.Items)
+	}
+	encoded, err := json.Marshal(page.Items[0].ReleaseDate)
+	if err != nil || string(encoded) != `"0000-00-00"` {
+		t.Errorf("json.Marshal() = %s, %v, want the text as answered", encoded, err)
+	}
+}
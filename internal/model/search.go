@@ -0,0 +1,128 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type SearchType string
+
+const (
+	SearchTypeAlbum    SearchType = "album"
+	SearchTypeArtist   SearchType = "artist"
+	SearchTypePlaylist SearchType = "playlist"
+	SearchTypeTrack    SearchType = "track"
+	SearchTypeShow     SearchType = "show"
+	SearchTypeEpisode  SearchType = "episode"
+)
+
+func (t SearchType) String() string {
+	return string(t)
+}
+
+type SearchTypes []SearchType
+
+func (s SearchTypes) String() string {
+	return strings.Join(lo.Map(s, func(searchType SearchType, _ int) string {
+		return searchType.String()
+	}), ",")
+}
+
+type IncludeExternal string
+
+const (
+	IncludeExternalAudio IncludeExternal = "audio"
+)
+
+func (i IncludeExternal) String() string {
+	return string(i)
+}
+
+// SearchQuery builds the q parameter of a search, combining free keywords with Spotify's field filters.
+// Every method returns a new query, so a base query can be shared and refined safely.
+type SearchQuery struct {
+	keywords string
+	filters  []string
+}
+
+func NewSearchQuery(keywords string) SearchQuery {
+	return SearchQuery{keywords: strings.TrimSpace(keywords)}
+}
+
+func (q SearchQuery) Album(name string) SearchQuery {
+	return q.withFilter("album", name)
+}
+
+func (q SearchQuery) Artist(name string) SearchQuery {
+	return q.withFilter("artist", name)
+}
+
+func (q SearchQuery) Track(name string) SearchQuery {
+	return q.withFilter("track", name)
+}
+
+func (q SearchQuery) Year(year int) SearchQuery {
+	return q.withFilter("year", strconv.Itoa(year))
+}
+
+func (q SearchQuery) YearRange(from int, to int) SearchQuery {
+	return q.withFilter("year", strconv.Itoa(from)+"-"+strconv.Itoa(to))
+}
+
+func (q SearchQuery) Genre(genre string) SearchQuery {
+	return q.withFilter("genre", genre)
+}
+
+func (q SearchQuery) ISRC(isrc string) SearchQuery {
+	return q.withFilter("isrc", isrc)
+}
+
+func (q SearchQuery) UPC(upc string) SearchQuery {
+	return q.withFilter("upc", upc)
+}
+
+// TagNew restricts album results to the ones released in the past two weeks.
+func (q SearchQuery) TagNew() SearchQuery {
+	return q.withFilter("tag", "new")
+}
+
+// TagHipster restricts album results to the ones with the lowest 10% popularity.
+func (q SearchQuery) TagHipster() SearchQuery {
+	return q.withFilter("tag", "hipster")
+}
+
+func (q SearchQuery) IsEmpty() bool {
+	return q.keywords == "" && len(q.filters) == 0
+}
+
+func (q SearchQuery) String() string {
+	return strings.Join(lo.Compact(append([]string{q.keywords}, q.filters...)), " ")
+}
+
+func (q SearchQuery) withFilter(field string, value string) SearchQuery {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return q
+	}
+	// quotes would end the quoted value early, so they are dropped
+	value = strings.ReplaceAll(value, `"`, "")
+	if strings.ContainsAny(value, " \t") {
+		value = `"` + value + `"`
+	}
+	filters := make([]string, len(q.filters), len(q.filters)+1)
+	copy(filters, q.filters)
+	return SearchQuery{keywords: q.keywords, filters: append(filters, field+":"+value)}
+}
+
+// SearchResult holds a page for each requested type, the others are nil. Tracks and artists are full objects
+// because that is what Spotify answers for them.
+type SearchResult struct {
+	Albums    *SimplifiedAlbumsPaginated    `json:"albums,omitempty"`
+	Artists   *ArtistsPaginated             `json:"artists,omitempty"`
+	Playlists *SimplifiedPlaylistsPaginated `json:"playlists,omitempty"`
+	Tracks    *TracksPaginated              `json:"tracks,omitempty"`
+	Shows     *SimplifiedShowsPaginated     `json:"shows,omitempty"`
+	Episodes  *SimplifiedEpisodesPaginated  `json:"episodes,omitempty"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import "testing"
+
+func TestSearchQuery_String(t *testing.T) {
+	base := NewSearchQuery("kind of blue")
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  string
+	}{
+		{name: "should keep plain keywords", query: base, want: "kind of blue"},
+		{name: "should quote filter values with spaces", query: base.Artist("Miles Davis"), want: `kind of blue artist:"Miles Davis"`},
+		{
+			name:  "should combine several filters in order",
+			query: NewSearchQuery("").Track("So What").Year(1959).Genre("jazz"),
+			want:  `track:"So What" year:1959 genre:jazz`,
+		},
+		{name: "should build year ranges", query: base.YearRange(1955, 1960), want: "kind of blue year:1955-1960"},
+		{name: "should build isrc and upc filters", query: NewSearchQuery("").ISRC("USSM15900113").UPC("886977187220"), want: "isrc:USSM15900113 upc:886977187220"},
+		{name: "should build tag filters", query: NewSearchQuery("").Album("blue").TagNew().TagHipster(), want: "album:blue tag:new tag:hipster"},
+		{name: "should drop quotes inside filter values", query: NewSearchQuery("").Album(`"Blue" Train`), want: `album:"Blue Train"`},
+		{name: "should ignore empty filter values", query: base.Artist("  "), want: "kind of blue"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchQuery_IsImmutable(t *testing.T) {
+	base := NewSearchQuery("blue").Artist("Miles Davis")
+	withYear := base.Year(1959)
+	withGenre := base.Genre("jazz")
+
+	if got := base.String(); got != `blue artist:"Miles Davis"` {
+		t.Errorf("base query changed to %q", got)
+	}
+	if got := withYear.String(); got != `blue artist:"Miles Davis" year:1959` {
+		t.Errorf("refined query = %q", got)
+	}
+	if got := withGenre.String(); got != `blue artist:"Miles Davis" genre:jazz` {
+		t.Errorf("refined query = %q", got)
+	}
+}
+
+func TestSearchQuery_IsEmpty(t *testing.T) {
+	if !NewSearchQuery(" ").IsEmpty() {
+		t.Errorf("IsEmpty() = false for blank query, want true")
+	}
+	if NewSearchQuery("").Genre("jazz").IsEmpty() {
+		t.Errorf("IsEmpty() = true for query with filters, want false")
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type ShowsIDs []ID
+
+func (a ShowsIDs) String() string {
+	return strings.Join(lo.Map(a, func(showID ID, _ int) string {
+		return showID.String()
+	}), ",")
+}
+
+type SimplifiedShow struct {
+	AvailableMarkets   []AvailableMarket `json:"available_markets"`
+	Copyrights         []Copyright       `json:"copyrights"`
+	Description        string            `json:"description"`
+	HTMLDescription    string            `json:"html_description"`
+	Explicit           bool              `json:"explicit"`
+	ExternalURLs       ExternalURLs      `json:"external_urls"`
+	Href               Href              `json:"href"`
+	ID                 ID                `json:"id"`
+	Images             []Image           `json:"images"`
+	IsExternallyHosted bool              `json:"is_externally_hosted"`
+	Languages          []string          `json:"languages"`
+	MediaType          string            `json:"media_type"`
+	Name               Name              `json:"name"`
+	Publisher          string            `json:"publisher"`
+	Type               Type              `json:"type"`
+	URI                URI               `json:"uri"`
+	TotalEpisodes      int               `json:"total_episodes"`
+}
+
+type SimplifiedShowsPaginated struct {
+	Pagination
+	Items []SimplifiedShow `json:"items"`
+}
+
+type Show struct {
+	SimplifiedShow
+	Episodes SimplifiedEpisodesPaginated `json:"episodes"`
+}
+
+type MultipleShows struct {
+	Shows []SimplifiedShow `json:"shows"`
+}
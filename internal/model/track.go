@@ -2,6 +2,7 @@ package model
 
 import (
 	"strings"
+	"time"
 
 	"github.com/samber/lo"
 )
@@ -20,13 +21,14 @@ type SimplifiedTrack struct {
 	DiscNumber       int                `json:"disc_number"`
 	DurationMs       int                `json:"duration_ms"`
 	Explicit         bool               `json:"explicit"`
-	ExternalURLs     ExternalURLs       `json:"external_ur_ls"`
+	ExternalURLs     ExternalURLs       `json:"external_urls"`
 	Href             Href               `json:"href"`
 	ID               ID                 `json:"id"`
 	IsPlayable       bool               `json:"is_playable"`
 	LinkedFrom       LinkedFrom         `json:"linked_from"`
 	Restrictions     Restrictions       `json:"restrictions"`
 	Name             Name               `json:"name"`
+	PreviewURL       *URL               `json:"preview_url"`
 	TrackNumber      int                `json:"track_number"`
 	Type             Type               `json:"type"`
 	URI              URI                `json:"uri"`
@@ -36,7 +38,7 @@ type SimplifiedTrack struct {
 type Track struct {
 	SimplifiedTrack
 	Album       SimplifiedAlbum `json:"album"`
-	ExternalIDs ExternalIDs     `json:"external_i_ds"`
+	ExternalIDs ExternalIDs     `json:"external_ids"`
 	Popularity  int             `json:"popularity"`
 }
 
@@ -48,3 +50,12 @@ type SimplifiedTracksPaginated struct {
 	Pagination
 	Items []SimplifiedTrack `json:"items"`
 }
+
+type TracksPaginated struct {
+	Pagination
+	Items []Track `json:"items"`
+}
+
+func (t SimplifiedTrack) Duration() time.Duration {
+	return time.Duration(t.DurationMs) * time.Millisecond
+}
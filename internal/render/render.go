@@ -0,0 +1,208 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+const (
+	FormatJSON        Format = "json"
+	FormatCompactJSON Format = "compact"
+	FormatNDJSON      Format = "ndjson"
+	FormatTable       Format = "table"
+	FormatCSV         Format = "csv"
+	FormatYAML        Format = "yaml"
+)
+
+var Formats = []Format{FormatJSON, FormatCompactJSON, FormatNDJSON, FormatTable, FormatCSV, FormatYAML}
+
+func (f Format) String() string {
+	return string(f)
+}
+
+func ParseFormat(format string) (Format, error) {
+	parsed := Format(strings.ToLower(strings.TrimSpace(format)))
+	if !lo.Contains(Formats, parsed) {
+		return "", fmt.Errorf("unknown output format %q, expected one of %s", format,
+			strings.Join(lo.Map(Formats, func(f Format, _ int) string { return f.String() }), ", "))
+	}
+	return parsed, nil
+}
+
+// Renderer writes any model, list of models or page of models in an output format.
+type Renderer interface {
+	Render(w io.Writer, v any) error
+}
+
+// ItemRenderer is implemented by renderers that write each item on its own, so the items of a paginated stream can
+// be written as they are fetched instead of once all of them are.
+type ItemRenderer interface {
+	RenderItem(w io.Writer, item any) error
+}
+
+// NewRenderer returns the renderer of format. Columns pick the fields shown by table and csv, as paths such as
+// "name" or "artists.name"; when empty the fields that fit in a cell are shown.
+func NewRenderer(format Format, columns []string) (Renderer, error) {
+	if len(columns) > 0 && format != FormatTable && format != FormatCSV {
+		return nil, fmt.Errorf("columns can only be selected for %s and %s output", FormatTable, FormatCSV)
+	}
+	switch format {
+	case FormatJSON:
+		return jsonRenderer{indent: "  "}, nil
+	case FormatCompactJSON:
+		return jsonRenderer{}, nil
+	case FormatNDJSON:
+		return ndjsonRenderer{}, nil
+	case FormatTable:
+		return tableRenderer{columns: columns}, nil
+	case FormatCSV:
+		return csvRenderer{columns: columns}, nil
+	case FormatYAML:
+		return yamlRenderer{}, nil
+	default:
+		_, err := ParseFormat(format.String())
+		return nil, err
+	}
+}
+
+type jsonRenderer struct {
+	indent string
+}
+
+func (r jsonRenderer) Render(w io.Writer, v any) error {
+	return writeJSON(w, v, r.indent)
+}
+
+// ndjsonRenderer writes a JSON document per line for each item of lists and pages.
+type ndjsonRenderer struct{}
+
+func (r ndjsonRenderer) Render(w io.Writer, v any) error {
+	value, err := toValue(v)
+	if err != nil {
+		return err
+	}
+	for _, row := range rowsOf(value) {
+		if err = writeJSON(w, row, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r ndjsonRenderer) RenderItem(w io.Writer, item any) error {
+	return writeJSON(w, item, "")
+}
+
+// tableRenderer writes the items of lists and pages as rows of aligned columns.
+type tableRenderer struct {
+	columns []string
+}
+
+func (r tableRenderer) Render(w io.Writer, v any) error {
+	columns, rows, err := tabulate(v, r.columns)
+	if err != nil || len(columns) == 0 {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	headers := lo.Map(columns, func(column string, _ int) string {
+		return strings.ToUpper(column)
+	})
+	_, _ = fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		cells := lo.Map(row, func(c string, _ int) string {
+			return strings.NewReplacer("\t", " ", "\n", " ").Replace(c)
+		})
+		_, _ = fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// csvRenderer writes the items of lists and pages as CSV records after a header record.
+type csvRenderer struct {
+	columns []string
+}
+
+func (r csvRenderer) Render(w io.Writer, v any) error {
+	columns, rows, err := tabulate(v, r.columns)
+	if err != nil || len(columns) == 0 {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err = cw.Write(columns); err != nil {
+		return err
+	}
+	if err = cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func tabulate(v any, columns []string) ([]string, [][]string, error) {
+	value, err := toValue(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := rowsOf(value)
+	if len(columns) == 0 {
+		columns = defaultColumns(rows)
+	}
+	cells := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		cells = append(cells, lo.Map(columns, func(column string, _ int) string {
+			return cell(lookup(row, column))
+		}))
+	}
+	return columns, cells, nil
+}
+
+type yamlRenderer struct{}
+
+func (r yamlRenderer) Render(w io.Writer, v any) error {
+	value, err := toValue(v)
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(yamlNode(value)); err != nil {
+		return fmt.Errorf("error writing yaml output - %w", err)
+	}
+	return encoder.Close()
+}
+
+func yamlNode(value any) *yaml.Node {
+	switch v := value.(type) {
+	case *object:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, key := range v.keys {
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, yamlNode(v.values[key]))
+		}
+		return node
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range v {
+			node.Content = append(node.Content, yamlNode(item))
+		}
+		return node
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: v.String()}
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: v.String()}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: cell(v)}
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: cell(v)}
+	}
+}
@@ -0,0 +1,238 @@
+package render
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"jezz-go-spotify-integration/internal/model"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const recordedResponsesDir = "../../test/data/api-responses/recorded"
+
+func testTracks() []model.SimplifiedTrack {
+	return []model.SimplifiedTrack{
+		{
+			Artists:    []model.SimplifiedArtist{{Name: "Miles Davis"}, {Name: "John Coltrane"}},
+			DurationMs: 545000,
+			ID:         "4vLYewWIvqHfKtJDk8c8tq",
+			Name:       "So What",
+		},
+		{
+			Artists:    []model.SimplifiedArtist{{Name: "Miles Davis"}},
+			DurationMs: 337000,
+			ID:         "7q3kkfAVpmcZ8g6JUThi3o",
+			Name:       "Freddie Freeloader, Take 1",
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Format
+		wantErr bool
+	}{
+		{input: "json", want: FormatJSON},
+		{input: " NDJSON ", want: FormatNDJSON},
+		{input: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseFormat(tt.input)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseFormat() = %q, %v, want %q, wantErr %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewRenderer_ColumnsOnlyForTabularFormats(t *testing.T) {
+	if _, err := NewRenderer(FormatJSON, []string{"name"}); err == nil {
+		t.Errorf("NewRenderer() error = nil, want columns rejected for json")
+	}
+	if _, err := NewRenderer(FormatCSV, []string{"name"}); err != nil {
+		t.Errorf("NewRenderer() error = %v, want columns accepted for csv", err)
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	page := model.SimplifiedTracksPaginated{
+		Pagination: model.Pagination{Limit: 2, Total: 2},
+		Items:      testTracks(),
+	}
+	tests := []struct {
+		name    string
+		format  Format
+		columns []string
+		value   any
+		want    string
+	}{
+		{
+			name:    "should write the items of a page as an aligned table",
+			format:  FormatTable,
+			columns: []string{"name", "artists.name", "duration_ms"},
+			value:   page,
+			want: "NAME                        ARTISTS.NAME                DURATION_MS\n" +
+				"So What                     Miles Davis, John Coltrane  545000\n" +
+				"Freddie Freeloader, Take 1  Miles Davis                 337000\n",
+		},
+		{
+			name:    "should write the items of a list as csv",
+			format:  FormatCSV,
+			columns: []string{"id", "name"},
+			value:   testTracks(),
+			want:    "id,name\n4vLYewWIvqHfKtJDk8c8tq,So What\n7q3kkfAVpmcZ8g6JUThi3o,\"Freddie Freeloader, Take 1\"\n",
+		},
+		{
+			name:   "should write scalar lists in a value column",
+			format: FormatTable,
+			value:  model.AvailableMarkets{Markets: []model.AvailableMarket{"BR", "US"}},
+			want:   "VALUE\nBR\nUS\n",
+		},
+		{
+			name:   "should write an item per line",
+			format: FormatNDJSON,
+			value:  model.MultipleArtists{Artists: []model.Artist{{SimplifiedArtist: model.SimplifiedArtist{Name: "Miles Davis"}}}},
+		},
+		{
+			name:   "should write compact json without escaping urls",
+			format: FormatCompactJSON,
+			value:  model.Image{URL: "https://i.scdn.co/image?a=1&b=2", Height: 64, Width: 64},
+			want:   `{"url":"https://i.scdn.co/image?a=1&b=2","height":64,"width":64}` + "\n",
+		},
+		{
+			name:   "should write yaml keeping the order of the fields",
+			format: FormatYAML,
+			value:  model.Image{URL: "https://i.scdn.co/image", Height: 64, Width: 64},
+			want:   "url: https://i.scdn.co/image\nheight: 64\nwidth: 64\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer, err := NewRenderer(tt.format, tt.columns)
+			if err != nil {
+				t.Fatalf("NewRenderer() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err = renderer.Render(&buf, tt.value); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if tt.want != "" && buf.String() != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+			if tt.format == FormatNDJSON && strings.Count(buf.String(), "\n") != 1 {
+				t.Errorf("Render() = %q, want a single line", buf.String())
+			}
+		})
+	}
+}
+
+func TestRenderer_DefaultColumns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (tableRenderer{}).Render(&buf, testTracks()); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	header := strings.Fields(strings.SplitN(buf.String(), "\n", 2)[0])
+	for _, want := range []string{"ARTISTS", "ID", "NAME", "DURATION_MS"} {
+		if !strings.Contains(strings.Join(header, " "), want) {
+			t.Errorf("Render() header = %v, want it to contain %s", header, want)
+		}
+	}
+	for _, noisy := range []string{"AVAILABLE_MARKETS", "EXTERNAL_URLS", "LINKED_FROM"} {
+		if strings.Contains(strings.Join(header, " "), noisy) {
+			t.Errorf("Render() header = %v, want %s left out", header, noisy)
+		}
+	}
+}
+
+// TestRenderer_RecordedResponses renders every recorded response in every format and checks the output parses back.
+func TestRenderer_RecordedResponses(t *testing.T) {
+	tests := []struct {
+		file   string
+		target func() any
+	}{
+		{file: "album.json", target: func() any { return &model.Album{} }},
+		{file: "albums.json", target: func() any { return &model.MultipleAlbums{} }},
+		{file: "album_tracks.json", target: func() any { return &model.SimplifiedTracksPaginated{} }},
+		{file: "new_releases.json", target: func() any { return &model.AlbumsNewRelease{} }},
+		{file: "artist.json", target: func() any { return &model.Artist{} }},
+		{file: "artists.json", target: func() any { return &model.MultipleArtists{} }},
+		{file: "artist_albums.json", target: func() any { return &model.SimplifiedArtistAlbumsPaginated{} }},
+		{file: "artist_top_tracks.json", target: func() any { return &model.MultipleTracks{} }},
+		{file: "track.json", target: func() any { return &model.Track{} }},
+		{file: "tracks.json", target: func() any { return &model.MultipleTracks{} }},
+		{file: "search.json", target: func() any { return &model.SearchResult{} }},
+		{file: "playlist.json", target: func() any { return &model.Playlist{} }},
+		{file: "playlist_items.json", target: func() any { return &model.PlaylistItemsPaginated{} }},
+		{file: "playlist_cover_image.json", target: func() any { return &[]model.Image{} }},
+		{file: "playlist_snapshot.json", target: func() any { return &model.PlaylistSnapshot{} }},
+		{file: "show.json", target: func() any { return &model.Show{} }},
+		{file: "shows.json", target: func() any { return &model.MultipleShows{} }},
+		{file: "show_episodes.json", target: func() any { return &model.SimplifiedEpisodesPaginated{} }},
+		{file: "episode.json", target: func() any { return &model.Episode{} }},
+		{file: "episodes.json", target: func() any { return &model.MultipleEpisodes{} }},
+		{file: "audiobook.json", target: func() any { return &model.Audiobook{} }},
+		{file: "audiobooks.json", target: func() any { return &model.MultipleAudiobooks{} }},
+		{file: "audiobook_chapters.json", target: func() any { return &model.SimplifiedChaptersPaginated{} }},
+		{file: "chapter.json", target: func() any { return &model.Chapter{} }},
+		{file: "chapters.json", target: func() any { return &model.MultipleChapters{} }},
+		{file: "categories.json", target: func() any { return &model.MultipleCategories{} }},
+		{file: "category.json", target: func() any { return &model.Category{} }},
+		{file: "markets.json", target: func() any { return &model.AvailableMarkets{} }},
+	}
+	for _, tt := range tests {
+		data, err := os.ReadFile(filepath.Join(recordedResponsesDir, tt.file))
+		if err != nil {
+			t.Fatalf("error reading recorded response - %v", err)
+		}
+		value := tt.target()
+		if err = json.Unmarshal(data, value); err != nil {
+			t.Fatalf("error decoding recorded response - %v", err)
+		}
+		for _, format := range Formats {
+			t.Run(tt.file+"/"+format.String(), func(t *testing.T) {
+				renderer, rErr := NewRenderer(format, nil)
+				if rErr != nil {
+					t.Fatalf("NewRenderer() error = %v", rErr)
+				}
+				var buf bytes.Buffer
+				if rErr = renderer.Render(&buf, value); rErr != nil {
+					t.Fatalf("Render() error = %v", rErr)
+				}
+				checkParses(t, format, buf.Bytes())
+			})
+		}
+	}
+}
+
+func checkParses(t *testing.T, format Format, output []byte) {
+	t.Helper()
+	var err error
+	switch format {
+	case FormatJSON, FormatCompactJSON:
+		err = json.Unmarshal(output, new(any))
+	case FormatNDJSON:
+		for _, line := range strings.Split(strings.TrimSuffix(string(output), "\n"), "\n") {
+			if err = json.Unmarshal([]byte(line), new(any)); err != nil {
+				break
+			}
+		}
+	case FormatCSV:
+		_, err = csv.NewReader(bytes.NewReader(output)).ReadAll()
+	case FormatYAML:
+		err = yaml.Unmarshal(output, new(any))
+	case FormatTable:
+		if len(bytes.TrimSpace(output)) == 0 {
+			t.Errorf("Render() wrote an empty table")
+		}
+	}
+	if err != nil {
+		t.Errorf("Render() output doesn't parse as %s - %v\n%s", format, err, output)
+	}
+}
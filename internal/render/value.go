@@ -0,0 +1,270 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// object is a JSON object that keeps the order of its keys, so columns and YAML keys follow the order of the fields
+// in the model.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func (o *object) get(key string) (any, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyJSON, err := marshalJSON(key)
+		if err != nil {
+			return nil, err
+		}
+		valueJSON, err := marshalJSON(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+		buf.Write(valueJSON)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// toValue turns v into its JSON representation made of *object, []any, json.Number, string, bool and nil. Going
+// through JSON makes every model render the way the API names its fields, custom marshalers included.
+func toValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing output - %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	value, err := decodeValue(decoder)
+	if err != nil {
+		return nil, fmt.Errorf("error reading output - %w", err)
+	}
+	return value, nil
+}
+
+func decodeValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		o := &object{values: map[string]any{}}
+		for decoder.More() {
+			keyToken, kErr := decoder.Token()
+			if kErr != nil {
+				return nil, kErr
+			}
+			key, _ := keyToken.(string)
+			value, vErr := decodeValue(decoder)
+			if vErr != nil {
+				return nil, vErr
+			}
+			if _, exists := o.values[key]; !exists {
+				o.keys = append(o.keys, key)
+			}
+			o.values[key] = value
+		}
+		_, err = decoder.Token()
+		return o, err
+	case json.Delim('['):
+		items := []any{}
+		for decoder.More() {
+			item, iErr := decodeValue(decoder)
+			if iErr != nil {
+				return nil, iErr
+			}
+			items = append(items, item)
+		}
+		_, err = decoder.Token()
+		return items, err
+	default:
+		return token, nil
+	}
+}
+
+// rowsOf finds the items to list in value: the elements of an array, the items of a page, or the rows of the fields
+// of a wrapper made only of lists and pages, such as {"albums": {...page...}} or a search result. Anything else is a
+// single row.
+func rowsOf(value any) []any {
+	switch v := value.(type) {
+	case []any:
+		return v
+	case *object:
+		if items, ok := v.get("items"); ok {
+			if rows, isList := items.([]any); isList {
+				return rows
+			}
+		}
+		var rows []any
+		for _, key := range v.keys {
+			switch inner := v.values[key].(type) {
+			case []any:
+				rows = append(rows, inner...)
+			case *object:
+				if _, isPage := inner.get("items"); !isPage {
+					return []any{v}
+				}
+				rows = append(rows, rowsOf(inner)...)
+			case nil:
+				// e.g. the types not searched for in a search result
+			default:
+				return []any{v}
+			}
+		}
+		if len(v.keys) == 0 {
+			return []any{v}
+		}
+		return rows
+	default:
+		return []any{v}
+	}
+}
+
+// scalarColumn is the only column of rows that are not objects, e.g. a list of markets
+const scalarColumn = "value"
+
+// noisyFields are left out of the default columns as they are long or only useful to machines
+var noisyFields = []string{
+	"available_markets", "copyrights", "external_ids", "external_urls", "href", "html_description", "icons",
+	"images", "linked_from", "preview_url", "restrictions", "uri",
+}
+
+// defaultColumns lists the fields of the rows that read well in a cell, in the order they first appear.
+func defaultColumns(rows []any) []string {
+	var columns []string
+	for _, row := range rows {
+		o, ok := row.(*object)
+		if !ok {
+			if !slices.Contains(columns, scalarColumn) {
+				columns = append(columns, scalarColumn)
+			}
+			continue
+		}
+		for _, key := range o.keys {
+			if !slices.Contains(columns, key) && !slices.Contains(noisyFields, key) && fitsInCell(o.values[key]) {
+				columns = append(columns, key)
+			}
+		}
+	}
+	if len(columns) == 0 && len(rows) > 0 {
+		if o, ok := rows[0].(*object); ok {
+			return o.keys
+		}
+	}
+	return columns
+}
+
+func fitsInCell(value any) bool {
+	switch v := value.(type) {
+	case *object:
+		_, named := v.get("name")
+		return named
+	case []any:
+		for _, item := range v {
+			if _, isList := item.([]any); isList || (isObject(item) && !fitsInCell(item)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+func isObject(value any) bool {
+	_, ok := value.(*object)
+	return ok
+}
+
+// lookup resolves a column path such as "album.name" or "artists.name" in row. Arrays along the path resolve to the
+// list of what the rest of the path resolves to in each of their items.
+func lookup(row any, column string) any {
+	if column == scalarColumn && !isObject(row) {
+		return row
+	}
+	value := row
+	for _, part := range strings.Split(column, ".") {
+		value = lookupPart(value, part)
+	}
+	return value
+}
+
+func lookupPart(value any, part string) any {
+	switch v := value.(type) {
+	case *object:
+		field, _ := v.get(part)
+		return field
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			items = append(items, lookupPart(item, part))
+		}
+		return items
+	default:
+		return nil
+	}
+}
+
+// cell formats value as text: objects by their name, lists joined by commas.
+func cell(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case *object:
+		if name, ok := v.get("name"); ok {
+			return cell(name)
+		}
+		data, _ := marshalJSON(v)
+		return string(data)
+	case []any:
+		cells := make([]string, 0, len(v))
+		for _, item := range v {
+			cells = append(cells, cell(item))
+		}
+		return strings.Join(cells, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, v, ""); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// writeJSON writes v followed by a new line without escaping HTML characters, which are common in URLs.
+func writeJSON(w io.Writer, v any, indent string) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+	return encoder.Encode(v)
+}
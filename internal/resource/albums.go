@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/model"
@@ -23,6 +24,7 @@ func NewSpotifyAlbumsResource(
 }
 
 func (r SpotifyAlbumsResource) GetAlbum(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	market *model.AvailableMarket,
 	albumID model.ID,
@@ -33,13 +35,14 @@ func (r SpotifyAlbumsResource) GetAlbum(
 	}
 	output := &model.Album{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return model.Album{}, fmt.Errorf("error executing album request for album ID - %s - %w", albumID.String(), err)
 	}
 	return *output, nil
 }
 
 func (r SpotifyAlbumsResource) GetAlbums(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	market *model.AvailableMarket,
 	albumsIDs model.AlbumsIDs,
@@ -55,13 +58,14 @@ func (r SpotifyAlbumsResource) GetAlbums(
 	}
 	output := &model.MultipleAlbums{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return []model.Album{}, fmt.Errorf("error executing album request for albums IDs - %s - %w", albumsIDs.String(), err)
 	}
 	return output.Albums, nil
 }
 
 func (r SpotifyAlbumsResource) GetAlbumTracks(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	market *model.AvailableMarket,
 	limit *model.Limit,
@@ -80,13 +84,14 @@ func (r SpotifyAlbumsResource) GetAlbumTracks(
 	}
 	output := &model.SimplifiedTracksPaginated{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return model.SimplifiedTracksPaginated{}, fmt.Errorf("error executing album tracks request for album ID - %s - %w", albumID.String(), err)
 	}
 	return *output, nil
 }
 
 func (r SpotifyAlbumsResource) GetNewReleases(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	limit *model.Limit,
 	offset *model.Offset,
@@ -102,7 +107,7 @@ func (r SpotifyAlbumsResource) GetNewReleases(
 	}
 	output := &model.AlbumsNewRelease{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return model.AlbumsNewRelease{}, fmt.Errorf("error executing new releases request - %w", err)
 	}
 	return *output, nil
@@ -112,5 +117,8 @@ func (r SpotifyAlbumsResource) validateAlbumsIDsLen(albumsIDs model.AlbumsIDs) e
 	if len(albumsIDs) < 1 {
 		return fmt.Errorf("error getting album - album id must not be null")
 	}
+	if len(albumsIDs) > MaxAlbumsIDs {
+		return fmt.Errorf("error getting album - at most %d album ids are allowed per request, got %d", MaxAlbumsIDs, len(albumsIDs))
+	}
 	return nil
 }
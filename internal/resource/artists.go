@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/model"
@@ -22,19 +23,21 @@ func NewSpotifyArtistsResource(
 }
 
 func (r SpotifyArtistsResource) GetArtist(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	artistID model.ID,
 ) (model.Artist, error) {
 	url := r.baseURL + APIVersion + ArtistsPath + "/" + artistID.String()
 	output := &model.Artist{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return model.Artist{}, fmt.Errorf("error executing artist request for astist ID - %s - %w", artistID.String(), err)
 	}
 	return *output, nil
 }
 
 func (r SpotifyArtistsResource) GetArtists(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	artistsIDs model.ArtistsIDs,
 ) ([]model.Artist, error) {
@@ -48,13 +51,14 @@ func (r SpotifyArtistsResource) GetArtists(
 	}
 	output := &model.MultipleArtists{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return []model.Artist{}, fmt.Errorf("error executing artist request for astists IDs - %s - %w", artistsIDs.String(), err)
 	}
 	return output.Artists, nil
 }
 
 func (r SpotifyArtistsResource) GetArtistAlbums(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	includeGroups *model.AlbumGroups,
 	market *model.AvailableMarket,
@@ -71,13 +75,14 @@ func (r SpotifyArtistsResource) GetArtistAlbums(
 	}
 	output := &model.SimplifiedArtistAlbumsPaginated{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return model.SimplifiedArtistAlbumsPaginated{}, fmt.Errorf("error executing artist albums request for astist ID - %s - %w", artistID.String(), err)
 	}
 	return *output, nil
 }
 
 func (r SpotifyArtistsResource) GetArtistTopTracks(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	market *model.AvailableMarket,
 	artistID model.ID,
@@ -88,7 +93,7 @@ func (r SpotifyArtistsResource) GetArtistTopTracks(
 	}
 	output := &model.MultipleTracks{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return []model.Track{}, fmt.Errorf("error executing artist top-tracks request for astist ID - %s - %w", artistID.String(), err)
 	}
 	return output.Tracks, nil
@@ -98,5 +103,8 @@ func (r SpotifyArtistsResource) validateArtistsIDsSize(artistsIDs model.ArtistsI
 	if len(artistsIDs) < 1 {
 		return fmt.Errorf("error getting artist - artist id must not be null")
 	}
+	if len(artistsIDs) > MaxArtistsIDs {
+		return fmt.Errorf("error getting artist - at most %d artist ids are allowed per request, got %d", MaxArtistsIDs, len(artistsIDs))
+	}
 	return nil
 }
@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/utils"
+)
+
+type SpotifyAudiobooksResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyAudiobooksResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) AudiobooksResource {
+	return SpotifyAudiobooksResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyAudiobooksResource) GetAudiobook(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	audiobookID model.ID,
+) (model.Audiobook, error) {
+	url := r.baseURL + APIVersion + AudiobooksPath + "/" + audiobookID.String()
+	queryParams := &model.QueryParams{
+		"market": market,
+	}
+	output := &model.Audiobook{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.Audiobook{}, fmt.Errorf("error executing audiobook request for audiobook ID - %s - %w", audiobookID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyAudiobooksResource) GetAudiobooks(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	audiobooksIDs model.AudiobooksIDs,
+) ([]model.Audiobook, error) {
+	if err := r.validateAudiobooksIDsSize(audiobooksIDs); err != nil {
+		return []model.Audiobook{}, err
+	}
+
+	url := r.baseURL + APIVersion + AudiobooksPath
+	queryParams := &model.QueryParams{
+		"ids":    audiobooksIDs,
+		"market": market,
+	}
+	output := &model.MultipleAudiobooks{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return []model.Audiobook{}, fmt.Errorf("error executing audiobook request for audiobooks IDs - %s - %w", audiobooksIDs.String(), err)
+	}
+	return output.Audiobooks, nil
+}
+
+func (r SpotifyAudiobooksResource) GetAudiobookChapters(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	limit *model.Limit,
+	offset *model.Offset,
+	audiobookID model.ID,
+) (model.SimplifiedChaptersPaginated, error) {
+	if err := utils.ValidatePaginationParams(limit, offset); err != nil {
+		return model.SimplifiedChaptersPaginated{}, fmt.Errorf("error creating audiobook chapters request for audiobook ID - %s - %w", audiobookID.String(), err)
+	}
+
+	url := r.baseURL + APIVersion + AudiobooksPath + "/" + audiobookID.String() + ChaptersPath
+	queryParams := &model.QueryParams{
+		"market": market,
+		"limit":  limit,
+		"offset": offset,
+	}
+	output := &model.SimplifiedChaptersPaginated{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.SimplifiedChaptersPaginated{}, fmt.Errorf("error executing audiobook chapters request for audiobook ID - %s - %w", audiobookID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyAudiobooksResource) validateAudiobooksIDsSize(audiobooksIDs model.AudiobooksIDs) error {
+	if len(audiobooksIDs) < 1 {
+		return fmt.Errorf("error getting audiobook - audiobook id must not be null")
+	}
+	if len(audiobooksIDs) > MaxAudiobooksIDs {
+		return fmt.Errorf("error getting audiobook - at most %d audiobook ids are allowed per request, got %d", MaxAudiobooksIDs, len(audiobooksIDs))
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyAudiobooksResource_GetAudiobook(t *testing.T) {
+	tests := []struct {
+		name   string
+		market *model.AvailableMarket
+	}{
+		{name: "should get audiobook for the given market", market: lo.ToPtr(model.AvailableMarket("BR"))},
+		{name: "should get audiobook without market", market: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/audiobooks/audiobook-1",
+				&model.QueryParams{"market": tt.market},
+				client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.Audiobook")).
+				Run(func(args mock.Arguments) {
+					args.Get(7).(*model.Audiobook).ID = "audiobook-1"
+				}).
+				Return(nil).Once()
+
+			r := NewSpotifyAudiobooksResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetAudiobook(context.Background(), "token", tt.market, "audiobook-1")
+
+			if err != nil {
+				t.Fatalf("GetAudiobook() error = %v", err)
+			}
+			if got.ID != "audiobook-1" {
+				t.Errorf("GetAudiobook() = %+v, want audiobook-1", got)
+			}
+		})
+	}
+}
+
+func TestSpotifyAudiobooksResource_GetAudiobooks(t *testing.T) {
+	tooManyIDs := make(model.AudiobooksIDs, MaxAudiobooksIDs+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = model.ID(fmt.Sprintf("audiobook-%d", i))
+	}
+	tests := []struct {
+		name          string
+		audiobooksIDs model.AudiobooksIDs
+		wantRequest   bool
+		wantErr       bool
+	}{
+		{name: "should get several audiobooks", audiobooksIDs: model.AudiobooksIDs{"audiobook-1", "audiobook-2"}, wantRequest: true},
+		{name: "should return error when no id is given", audiobooksIDs: model.AudiobooksIDs{}, wantErr: true},
+		{name: "should return error when more ids than allowed are given", audiobooksIDs: tooManyIDs, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			market := lo.ToPtr(model.AvailableMarket("BR"))
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/audiobooks",
+					&model.QueryParams{"ids": tt.audiobooksIDs, "market": market},
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.MultipleAudiobooks")).
+					Run(func(args mock.Arguments) {
+						output := args.Get(7).(*model.MultipleAudiobooks)
+						output.Audiobooks = make([]model.Audiobook, 2)
+						output.Audiobooks[0].ID = "audiobook-1"
+						output.Audiobooks[1].ID = "audiobook-2"
+					}).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyAudiobooksResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetAudiobooks(context.Background(), "token", market, tt.audiobooksIDs)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetAudiobooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequest && len(got) != 2 {
+				t.Errorf("GetAudiobooks() = %+v, want 2 audiobooks", got)
+			}
+		})
+	}
+}
+
+func TestSpotifyAudiobooksResource_GetAudiobookChapters(t *testing.T) {
+	tests := []struct {
+		name        string
+		market      *model.AvailableMarket
+		limit       *model.Limit
+		offset      *model.Offset
+		wantRequest bool
+		wantErr     bool
+	}{
+		{
+			name:        "should get audiobook chapters page for the given market",
+			market:      lo.ToPtr(model.AvailableMarket("BR")),
+			limit:       lo.ToPtr(model.Limit(10)),
+			offset:      lo.ToPtr(model.Offset(20)),
+			wantRequest: true,
+		},
+		{name: "should get audiobook chapters without optional params", wantRequest: true},
+		{name: "should return error when limit is invalid", limit: lo.ToPtr(model.Limit(51)), wantErr: true},
+		{name: "should return error when offset is invalid", offset: lo.ToPtr(model.Offset(-1)), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/audiobooks/audiobook-1/chapters",
+					&model.QueryParams{"market": tt.market, "limit": tt.limit, "offset": tt.offset},
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.SimplifiedChaptersPaginated")).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyAudiobooksResource(httpClient, "https://api.spotify.com")
+			_, err := r.GetAudiobookChapters(context.Background(), "token", tt.market, tt.limit, tt.offset, "audiobook-1")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetAudiobookChapters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/utils"
+	neturl "net/url"
+)
+
+type SpotifyBrowseResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyBrowseResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) BrowseResource {
+	return SpotifyBrowseResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyBrowseResource) GetCategories(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	locale *model.Locale,
+	limit *model.Limit,
+	offset *model.Offset,
+) (model.CategoriesPaginated, error) {
+	if err := utils.ValidatePaginationParams(limit, offset); err != nil {
+		return model.CategoriesPaginated{}, fmt.Errorf("error creating categories request - %w", err)
+	}
+
+	url := r.baseURL + APIVersion + CategoriesPath
+	queryParams := &model.QueryParams{
+		"locale": locale,
+		"limit":  limit,
+		"offset": offset,
+	}
+	output := &model.MultipleCategories{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.CategoriesPaginated{}, fmt.Errorf("error executing categories request - %w", err)
+	}
+	return output.Categories, nil
+}
+
+func (r SpotifyBrowseResource) GetCategory(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	locale *model.Locale,
+	categoryID model.ID,
+) (model.Category, error) {
+	// the category ID is taken as given, so it must not be able to leave the category path
+	url := r.baseURL + APIVersion + CategoriesPath + "/" + neturl.PathEscape(categoryID.String())
+	queryParams := &model.QueryParams{
+		"locale": locale,
+	}
+	output := &model.Category{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.Category{}, fmt.Errorf("error executing category request for category ID - %s - %w", categoryID.String(), err)
+	}
+	return *output, nil
+}
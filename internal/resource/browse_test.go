@@ -0,0 +1,99 @@
+package resource
+
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/client"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyBrowseResource_GetCategories(t *testing.T) {
+	tests := []struct {
+		name        string
+		locale      *model.Locale
+		limit       *model.Limit
+		offset      *model.Offset
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should get categories for locale", locale: lo.ToPtr(model.Locale("es_MX")), limit: lo.ToPtr(model.Limit(20)), wantRequest: true},
+		{name: "should get categories without locale", wantRequest: true},
+		{name: "should return error when limit is invalid", limit: lo.ToPtr(model.Limit(51)), wantErr: true},
+		{name: "should return error when offset is invalid", offset: lo.ToPtr(model.Offset(-1)), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/browse/categories",
+					&model.QueryParams{"locale": tt.locale, "limit": tt.limit, "offset": tt.offset},
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.MultipleCategories")).
+					Run(func(args mock.Arguments) {
+						args.Get(7).(*model.MultipleCategories).Categories.Items = []model.Category{{ID: "dinner"}}
+					}).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyBrowseResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetCategories(context.Background(), "token", tt.locale, tt.limit, tt.offset)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetCategories() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequest && (len(got.Items) != 1 || got.Items[0].ID != "dinner") {
+				t.Errorf("GetCategories() = %+v, want the dinner category", got)
+			}
+		})
+	}
+}
+
+func TestSpotifyBrowseResource_GetCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID model.ID
+		wantURL    string
+	}{
+		{name: "should get category by id", categoryID: "dinner", wantURL: "https://api.spotify.com/v1/browse/categories/dinner"},
+		{name: "should escape path separators in the id", categoryID: "../me", wantURL: "https://api.spotify.com/v1/browse/categories/..%2Fme"},
+		{name: "should escape query separators in the id", categoryID: "a?b", wantURL: "https://api.spotify.com/v1/browse/categories/a%3Fb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			httpClient.On("DoRequest", mock.Anything, model.HTTPGet, tt.wantURL,
+				&model.QueryParams{"locale": (*model.Locale)(nil)},
+				client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.Category")).
+				Return(nil).Once()
+
+			r := NewSpotifyBrowseResource(httpClient, "https://api.spotify.com")
+			if _, err := r.GetCategory(context.Background(), "token", nil, tt.categoryID); err != nil {
+				t.Errorf("GetCategory() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestSpotifyMarketsResource_GetAvailableMarkets(t *testing.T) {
+	httpClient := mocks.NewHTTPApiClient(t)
+	httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/markets",
+		&model.QueryParams{}, client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.AvailableMarkets")).
+		Run(func(args mock.Arguments) {
+			args.Get(7).(*model.AvailableMarkets).Markets = []model.AvailableMarket{"BR", "MX"}
+		}).
+		Return(nil).Once()
+
+	r := NewSpotifyMarketsResource(httpClient, "https://api.spotify.com")
+	got, err := r.GetAvailableMarkets(context.Background(), "token")
+
+	if err != nil {
+		t.Fatalf("GetAvailableMarkets() error = %v", err)
+	}
+	if want := []model.AvailableMarket{"BR", "MX"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAvailableMarkets() = %v, want %v", got, want)
+	}
+}
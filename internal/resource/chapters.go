@@ -0,0 +1,74 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+)
+
+type SpotifyChaptersResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyChaptersResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) ChaptersResource {
+	return SpotifyChaptersResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyChaptersResource) GetChapter(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	chapterID model.ID,
+) (model.Chapter, error) {
+	url := r.baseURL + APIVersion + ChaptersPath + "/" + chapterID.String()
+	queryParams := &model.QueryParams{
+		"market": market,
+	}
+	output := &model.Chapter{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.Chapter{}, fmt.Errorf("error executing chapter request for chapter ID - %s - %w", chapterID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyChaptersResource) GetChapters(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	chaptersIDs model.ChaptersIDs,
+) ([]model.Chapter, error) {
+	if err := r.validateChaptersIDsSize(chaptersIDs); err != nil {
+		return []model.Chapter{}, err
+	}
+
+	url := r.baseURL + APIVersion + ChaptersPath
+	queryParams := &model.QueryParams{
+		"ids":    chaptersIDs,
+		"market": market,
+	}
+	output := &model.MultipleChapters{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return []model.Chapter{}, fmt.Errorf("error executing chapter request for chapters IDs - %s - %w", chaptersIDs.String(), err)
+	}
+	return output.Chapters, nil
+}
+
+func (r SpotifyChaptersResource) validateChaptersIDsSize(chaptersIDs model.ChaptersIDs) error {
+	if len(chaptersIDs) < 1 {
+		return fmt.Errorf("error getting chapter - chapter id must not be null")
+	}
+	if len(chaptersIDs) > MaxChaptersIDs {
+		return fmt.Errorf("error getting chapter - at most %d chapter ids are allowed per request, got %d", MaxChaptersIDs, len(chaptersIDs))
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyChaptersResource_GetChapter(t *testing.T) {
+	tests := []struct {
+		name   string
+		market *model.AvailableMarket
+	}{
+		{name: "should get chapter for the given market", market: lo.ToPtr(model.AvailableMarket("BR"))},
+		{name: "should get chapter without market", market: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/chapters/chapter-1",
+				&model.QueryParams{"market": tt.market},
+				client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.Chapter")).
+				Run(func(args mock.Arguments) {
+					args.Get(7).(*model.Chapter).ID = "chapter-1"
+				}).
+				Return(nil).Once()
+
+			r := NewSpotifyChaptersResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetChapter(context.Background(), "token", tt.market, "chapter-1")
+
+			if err != nil {
+				t.Fatalf("GetChapter() error = %v", err)
+			}
+			if got.ID != "chapter-1" {
+				t.Errorf("GetChapter() = %+v, want chapter-1", got)
+			}
+		})
+	}
+}
+
+func TestSpotifyChaptersResource_GetChapters(t *testing.T) {
+	tooManyIDs := make(model.ChaptersIDs, MaxChaptersIDs+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = model.ID(fmt.Sprintf("chapter-%d", i))
+	}
+	tests := []struct {
+		name        string
+		chaptersIDs model.ChaptersIDs
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should get several chapters", chaptersIDs: model.ChaptersIDs{"chapter-1", "chapter-2"}, wantRequest: true},
+		{name: "should return error when no id is given", chaptersIDs: model.ChaptersIDs{}, wantErr: true},
+		{name: "should return error when more ids than allowed are given", chaptersIDs: tooManyIDs, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			market := lo.ToPtr(model.AvailableMarket("BR"))
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/chapters",
+					&model.QueryParams{"ids": tt.chaptersIDs, "market": market},
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.MultipleChapters")).
+					Run(func(args mock.Arguments) {
+						output := args.Get(7).(*model.MultipleChapters)
+						output.Chapters = make([]model.Chapter, 2)
+						output.Chapters[0].ID = "chapter-1"
+						output.Chapters[1].ID = "chapter-2"
+					}).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyChaptersResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetChapters(context.Background(), "token", market, tt.chaptersIDs)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetChapters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequest && len(got) != 2 {
+				t.Errorf("GetChapters() = %+v, want 2 chapters", got)
+			}
+		})
+	}
+}
@@ -7,4 +7,34 @@ const (
 	TracksPath      = "/tracks"
 	TopTracksPath   = "/top-tracks"
 	NewReleasesPath = "/browse/new-releases"
+	SearchPath      = "/search"
+	PlaylistsPath   = "/playlists"
+	ImagesPath      = "/images"
+	UsersPath       = "/users"
+	ShowsPath       = "/shows"
+	EpisodesPath    = "/episodes"
+	AudiobooksPath  = "/audiobooks"
+	ChaptersPath    = "/chapters"
+	CategoriesPath  = "/browse/categories"
+	MarketsPath     = "/markets"
 )
+
+// Maximum number of IDs accepted by the endpoints that fetch several items at once
+const (
+	MaxAlbumsIDs     = 20
+	MaxArtistsIDs    = 50
+	MaxTracksIDs     = 50
+	MaxShowsIDs      = 50
+	MaxEpisodesIDs   = 50
+	MaxAudiobooksIDs = 50
+	MaxChaptersIDs   = 50
+)
+
+// MaxSearchOffset is the highest offset the search endpoint accepts
+const MaxSearchOffset = 1000
+
+// MaxPlaylistItemsLimit is the largest page of playlist items, above the limit of the other paginated endpoints
+const MaxPlaylistItemsLimit = 100
+
+// MaxPlaylistItemsURIs is the highest number of items that can be added, replaced or removed per request
+const MaxPlaylistItemsURIs = 100
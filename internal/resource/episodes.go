@@ -0,0 +1,74 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+)
+
+type SpotifyEpisodesResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyEpisodesResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) EpisodesResource {
+	return SpotifyEpisodesResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyEpisodesResource) GetEpisode(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	episodeID model.ID,
+) (model.Episode, error) {
+	url := r.baseURL + APIVersion + EpisodesPath + "/" + episodeID.String()
+	queryParams := &model.QueryParams{
+		"market": market,
+	}
+	output := &model.Episode{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.Episode{}, fmt.Errorf("error executing episode request for episode ID - %s - %w", episodeID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyEpisodesResource) GetEpisodes(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	episodesIDs model.EpisodesIDs,
+) ([]model.Episode, error) {
+	if err := r.validateEpisodesIDsSize(episodesIDs); err != nil {
+		return []model.Episode{}, err
+	}
+
+	url := r.baseURL + APIVersion + EpisodesPath
+	queryParams := &model.QueryParams{
+		"ids":    episodesIDs,
+		"market": market,
+	}
+	output := &model.MultipleEpisodes{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return []model.Episode{}, fmt.Errorf("error executing episode request for episodes IDs - %s - %w", episodesIDs.String(), err)
+	}
+	return output.Episodes, nil
+}
+
+func (r SpotifyEpisodesResource) validateEpisodesIDsSize(episodesIDs model.EpisodesIDs) error {
+	if len(episodesIDs) < 1 {
+		return fmt.Errorf("error getting episode - episode id must not be null")
+	}
+	if len(episodesIDs) > MaxEpisodesIDs {
+		return fmt.Errorf("error getting episode - at most %d episode ids are allowed per request, got %d", MaxEpisodesIDs, len(episodesIDs))
+	}
+	return nil
+}
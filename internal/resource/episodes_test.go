@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyEpisodesResource_GetEpisode(t *testing.T) {
+	tests := []struct {
+		name   string
+		market *model.AvailableMarket
+	}{
+		{name: "should get episode for the given market", market: lo.ToPtr(model.AvailableMarket("BR"))},
+		{name: "should get episode without market", market: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/episodes/episode-1",
+				&model.QueryParams{"market": tt.market},
+				client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.Episode")).
+				Run(func(args mock.Arguments) {
+					args.Get(7).(*model.Episode).ID = "episode-1"
+				}).
+				Return(nil).Once()
+
+			r := NewSpotifyEpisodesResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetEpisode(context.Background(), "token", tt.market, "episode-1")
+
+			if err != nil {
+				t.Fatalf("GetEpisode() error = %v", err)
+			}
+			if got.ID != "episode-1" {
+				t.Errorf("GetEpisode() = %+v, want episode-1", got)
+			}
+		})
+	}
+}
+
+func TestSpotifyEpisodesResource_GetEpisodes(t *testing.T) {
+	tooManyIDs := make(model.EpisodesIDs, MaxEpisodesIDs+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = model.ID(fmt.Sprintf("episode-%d", i))
+	}
+	tests := []struct {
+		name        string
+		episodesIDs model.EpisodesIDs
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should get several episodes", episodesIDs: model.EpisodesIDs{"episode-1", "episode-2"}, wantRequest: true},
+		{name: "should return error when no id is given", episodesIDs: model.EpisodesIDs{}, wantErr: true},
+		{name: "should return error when more ids than allowed are given", episodesIDs: tooManyIDs, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			market := lo.ToPtr(model.AvailableMarket("BR"))
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/episodes",
+					&model.QueryParams{"ids": tt.episodesIDs, "market": market},
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.MultipleEpisodes")).
+					Run(func(args mock.Arguments) {
+						output := args.Get(7).(*model.MultipleEpisodes)
+						output.Episodes = make([]model.Episode, 2)
+						output.Episodes[0].ID = "episode-1"
+						output.Episodes[1].ID = "episode-2"
+					}).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyEpisodesResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetEpisodes(context.Background(), "token", market, tt.episodesIDs)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetEpisodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequest && len(got) != 2 {
+				t.Errorf("GetEpisodes() = %+v, want 2 episodes", got)
+			}
+		})
+	}
+}
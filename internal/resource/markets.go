@@ -0,0 +1,36 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+)
+
+type SpotifyMarketsResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyMarketsResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) MarketsResource {
+	return SpotifyMarketsResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyMarketsResource) GetAvailableMarkets(
+	ctx context.Context,
+	accessToken model.AccessToken,
+) ([]model.AvailableMarket, error) {
+	url := r.baseURL + APIVersion + MarketsPath
+	output := &model.AvailableMarkets{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return []model.AvailableMarket{}, fmt.Errorf("error executing available markets request - %w", err)
+	}
+	return output.Markets, nil
+}
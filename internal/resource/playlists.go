@@ -0,0 +1,210 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/utils"
+	neturl "net/url"
+)
+
+type SpotifyPlaylistsResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyPlaylistsResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) PlaylistsResource {
+	return SpotifyPlaylistsResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyPlaylistsResource) GetPlaylist(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	fields *model.Fields,
+	additionalTypes *model.AdditionalTypes,
+	playlistID model.ID,
+) (model.Playlist, error) {
+	url := r.baseURL + APIVersion + PlaylistsPath + "/" + playlistID.String()
+	queryParams := &model.QueryParams{
+		"market":           market,
+		"fields":           fields,
+		"additional_types": additionalTypes,
+	}
+	output := &model.Playlist{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.Playlist{}, fmt.Errorf("error executing playlist request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyPlaylistsResource) GetPlaylistItems(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	fields *model.Fields,
+	additionalTypes *model.AdditionalTypes,
+	limit *model.Limit,
+	offset *model.Offset,
+	playlistID model.ID,
+) (model.PlaylistItemsPaginated, error) {
+	if err := utils.ValidatePaginationParamsWithMax(limit, offset, MaxPlaylistItemsLimit); err != nil {
+		return model.PlaylistItemsPaginated{}, fmt.Errorf("error creating playlist items request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+
+	url := r.baseURL + APIVersion + PlaylistsPath + "/" + playlistID.String() + TracksPath
+	queryParams := &model.QueryParams{
+		"market":           market,
+		"fields":           fields,
+		"additional_types": additionalTypes,
+		"limit":            limit,
+		"offset":           offset,
+	}
+	output := &model.PlaylistItemsPaginated{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.PlaylistItemsPaginated{}, fmt.Errorf("error executing playlist items request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyPlaylistsResource) GetPlaylistCoverImage(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	playlistID model.ID,
+) ([]model.Image, error) {
+	url := r.baseURL + APIVersion + PlaylistsPath + "/" + playlistID.String() + ImagesPath
+	output := &[]model.Image{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return []model.Image{}, fmt.Errorf("error executing playlist cover image request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyPlaylistsResource) CreatePlaylist(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	userID model.ID,
+	details model.PlaylistDetails,
+) (model.Playlist, error) {
+	if details.Name == nil || *details.Name == "" {
+		return model.Playlist{}, fmt.Errorf("error creating playlist for user ID - %s - name must not be empty", userID.String())
+	}
+
+	// the user ID is taken as given, so it must not be able to leave the user path
+	url := r.baseURL + APIVersion + UsersPath + "/" + neturl.PathEscape(userID.String()) + PlaylistsPath
+	output := &model.Playlist{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPPost, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, details, output); err != nil {
+		return model.Playlist{}, fmt.Errorf("error executing create playlist request for user ID - %s - %w", userID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyPlaylistsResource) ChangePlaylistDetails(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	playlistID model.ID,
+	details model.PlaylistDetails,
+) error {
+	if details == (model.PlaylistDetails{}) {
+		return fmt.Errorf("error changing playlist details for playlist ID - %s - no detail to change was provided", playlistID.String())
+	}
+
+	url := r.baseURL + APIVersion + PlaylistsPath + "/" + playlistID.String()
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPPut, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, details, nil); err != nil {
+		return fmt.Errorf("error executing change playlist details request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+	return nil
+}
+
+func (r SpotifyPlaylistsResource) AddPlaylistItems(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	playlistID model.ID,
+	request model.AddPlaylistItemsRequest,
+) (model.SnapshotID, error) {
+	if err := r.validatePlaylistItemsURIsSize(len(request.URIs)); err != nil {
+		return "", fmt.Errorf("error creating add playlist items request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+	return r.mutatePlaylistItems(ctx, accessToken, model.HTTPPost, "add", playlistID, request)
+}
+
+func (r SpotifyPlaylistsResource) ReorderPlaylistItems(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	playlistID model.ID,
+	request model.ReorderPlaylistItemsRequest,
+) (model.SnapshotID, error) {
+	if request.RangeStart < 0 || request.InsertBefore < 0 || (request.RangeLength != nil && *request.RangeLength < 1) {
+		return "", fmt.Errorf("error creating reorder playlist items request for playlist ID - %s - range start and insert before must not be negative and range length must be above 0", playlistID.String())
+	}
+	// moving the same range twice doesn't leave the playlist as moving it once, so a reorder the API applied before
+	// failing must not be repeated
+	return r.mutatePlaylistItems(client.NonIdempotent(ctx), accessToken, model.HTTPPut, "reorder", playlistID, request)
+}
+
+func (r SpotifyPlaylistsResource) ReplacePlaylistItems(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	playlistID model.ID,
+	request model.ReplacePlaylistItemsRequest,
+) (model.SnapshotID, error) {
+	// an empty list of uris is valid and clears the playlist
+	if len(request.URIs) > MaxPlaylistItemsURIs {
+		return "", fmt.Errorf("error creating replace playlist items request for playlist ID - %s - at most %d items are allowed per request, got %d", playlistID.String(), MaxPlaylistItemsURIs, len(request.URIs))
+	}
+	if request.URIs == nil {
+		request.URIs = []model.URI{}
+	}
+	return r.mutatePlaylistItems(ctx, accessToken, model.HTTPPut, "replace", playlistID, request)
+}
+
+func (r SpotifyPlaylistsResource) RemovePlaylistItems(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	playlistID model.ID,
+	request model.RemovePlaylistItemsRequest,
+) (model.SnapshotID, error) {
+	if err := r.validatePlaylistItemsURIsSize(len(request.Tracks)); err != nil {
+		return "", fmt.Errorf("error creating remove playlist items request for playlist ID - %s - %w", playlistID.String(), err)
+	}
+	return r.mutatePlaylistItems(ctx, accessToken, model.HTTPDelete, "remove", playlistID, request)
+}
+
+func (r SpotifyPlaylistsResource) mutatePlaylistItems(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	method model.HTTPMethod,
+	operation string,
+	playlistID model.ID,
+	request any,
+) (model.SnapshotID, error) {
+	url := r.baseURL + APIVersion + PlaylistsPath + "/" + playlistID.String() + TracksPath
+	output := &model.PlaylistSnapshot{}
+
+	if err := r.httpClient.DoRequest(ctx, method, url, &model.QueryParams{}, client.ContentTypeJSON, &accessToken, request, output); err != nil {
+		return "", fmt.Errorf("error executing %s playlist items request for playlist ID - %s - %w", operation, playlistID.String(), err)
+	}
+	return output.SnapshotID, nil
+}
+
+func (r SpotifyPlaylistsResource) validatePlaylistItemsURIsSize(size int) error {
+	if size < 1 {
+		return fmt.Errorf("at least one item uri must be provided")
+	}
+	if size > MaxPlaylistItemsURIs {
+		return fmt.Errorf("at most %d items are allowed per request, got %d", MaxPlaylistItemsURIs, size)
+	}
+	return nil
+}
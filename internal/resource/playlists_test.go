@@ -0,0 +1,120 @@
+package resource
+
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/client"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyPlaylistsResource_MutatePlaylistItems_ServerErrorRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(r PlaylistsResource) (model.SnapshotID, error)
+		wantCalls int32
+		wantErr   bool
+	}{
+		{
+			name: "should not retry a reorder the API may have applied",
+			mutate: func(r PlaylistsResource) (model.SnapshotID, error) {
+				return r.ReorderPlaylistItems(context.Background(), "token", "playlist-1", model.ReorderPlaylistItemsRequest{RangeStart: 0, InsertBefore: 3})
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "should retry a replace, which leaves the same playlist when repeated",
+			mutate: func(r PlaylistsResource) (model.SnapshotID, error) {
+				return r.ReplacePlaylistItems(context.Background(), "token", "playlist-1", model.ReplacePlaylistItemsRequest{})
+			},
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				_, _ = w.Write([]byte(`{"snapshot_id":"snapshot-2"}`))
+			}))
+			defer server.Close()
+			httpClient := client.NewCustomHTTPApiClient(client.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+			_, err := tt.mutate(NewSpotifyPlaylistsResource(httpClient, server.URL))
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("mutate error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("mutate calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestSpotifyPlaylistsResource_GetPlaylistItems_Limit(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       model.Limit
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should accept pages above the limit of the other endpoints", limit: 100, wantRequest: true},
+		{name: "should return error when limit is above 100", limit: 101, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/playlists/playlist-1/tracks",
+					mock.MatchedBy(func(params *model.QueryParams) bool { return *(*params)["limit"].(*model.Limit) == tt.limit }),
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.PlaylistItemsPaginated")).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyPlaylistsResource(httpClient, "https://api.spotify.com")
+			_, err := r.GetPlaylistItems(context.Background(), "token", nil, nil, nil, lo.ToPtr(tt.limit), nil, "playlist-1")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPlaylistItems() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpotifyPlaylistsResource_CreatePlaylist(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  model.ID
+		wantURL string
+	}{
+		{name: "should create playlist for user id", userID: "smedjan", wantURL: "https://api.spotify.com/v1/users/smedjan/playlists"},
+		{name: "should escape path separators in the id", userID: "../me", wantURL: "https://api.spotify.com/v1/users/..%2Fme/playlists"},
+		{name: "should escape query separators in the id", userID: "a?b", wantURL: "https://api.spotify.com/v1/users/a%3Fb/playlists"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := model.PlaylistDetails{Name: lo.ToPtr("Road trip")}
+			httpClient := mocks.NewHTTPApiClient(t)
+			httpClient.On("DoRequest", mock.Anything, model.HTTPPost, tt.wantURL, &model.QueryParams{},
+				client.ContentTypeJSON, mock.Anything, details, mock.AnythingOfType("*model.Playlist")).
+				Return(nil).Once()
+
+			r := NewSpotifyPlaylistsResource(httpClient, "https://api.spotify.com")
+			if _, err := r.CreatePlaylist(context.Background(), "token", tt.userID, details); err != nil {
+				t.Errorf("CreatePlaylist() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/utils"
+
+	"github.com/samber/lo"
+)
+
+type SpotifySearchResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifySearchResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) SearchResource {
+	return SpotifySearchResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifySearchResource) Search(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	query model.SearchQuery,
+	searchTypes model.SearchTypes,
+	market *model.AvailableMarket,
+	limit *model.Limit,
+	offset *model.Offset,
+	includeExternal *model.IncludeExternal,
+) (model.SearchResult, error) {
+	if err := r.validateSearchParams(query, searchTypes, limit, offset); err != nil {
+		return model.SearchResult{}, fmt.Errorf("error creating search request for query - %s - %w", query.String(), err)
+	}
+
+	url := r.baseURL + APIVersion + SearchPath
+	queryParams := &model.QueryParams{
+		"q":                query,
+		"type":             searchTypes,
+		"market":           market,
+		"limit":            limit,
+		"offset":           offset,
+		"include_external": includeExternal,
+	}
+	output := &model.SearchResult{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.SearchResult{}, fmt.Errorf("error executing search request for query - %s - %w", query.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifySearchResource) validateSearchParams(
+	query model.SearchQuery,
+	searchTypes model.SearchTypes,
+	limit *model.Limit,
+	offset *model.Offset,
+) error {
+	if query.IsEmpty() {
+		return fmt.Errorf("query must not be empty")
+	}
+	if len(searchTypes) < 1 {
+		return fmt.Errorf("at least one search type must be provided")
+	}
+	validTypes := []model.SearchType{
+		model.SearchTypeAlbum,
+		model.SearchTypeArtist,
+		model.SearchTypePlaylist,
+		model.SearchTypeTrack,
+		model.SearchTypeShow,
+		model.SearchTypeEpisode,
+	}
+	if invalidTypes := lo.Without(searchTypes, validTypes...); len(invalidTypes) > 0 {
+		return fmt.Errorf("search types %s are invalid - must be one of %s", model.SearchTypes(invalidTypes).String(), model.SearchTypes(validTypes).String())
+	}
+	if offset != nil && offset.Int() > MaxSearchOffset {
+		return fmt.Errorf("offset is invalid - must not be above %d", MaxSearchOffset)
+	}
+	return utils.ValidatePaginationParams(limit, offset)
+}
@@ -0,0 +1,89 @@
+package resource
+
+import (
+	"context"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifySearchResource_Search(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       model.SearchQuery
+		searchTypes model.SearchTypes
+		limit       *model.Limit
+		offset      *model.Offset
+		wantRequest bool
+		wantErr     bool
+	}{
+		{
+			name:        "should search with every param",
+			query:       model.NewSearchQuery("blue").Artist("Miles Davis"),
+			searchTypes: model.SearchTypes{model.SearchTypeAlbum, model.SearchTypeTrack},
+			limit:       lo.ToPtr(model.Limit(5)),
+			offset:      lo.ToPtr(model.Offset(10)),
+			wantRequest: true,
+		},
+		{
+			name:        "should return error when query is empty",
+			query:       model.NewSearchQuery(""),
+			searchTypes: model.SearchTypes{model.SearchTypeAlbum},
+			wantErr:     true,
+		},
+		{
+			name:    "should return error when no search type is given",
+			query:   model.NewSearchQuery("blue"),
+			wantErr: true,
+		},
+		{
+			name:        "should return error when search type is unknown",
+			query:       model.NewSearchQuery("blue"),
+			searchTypes: model.SearchTypes{model.SearchTypeAlbum, "podcast"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return error when offset is above the search limit",
+			query:       model.NewSearchQuery("blue"),
+			searchTypes: model.SearchTypes{model.SearchTypeAlbum},
+			offset:      lo.ToPtr(model.Offset(MaxSearchOffset + 1)),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			includeExternal := lo.ToPtr(model.IncludeExternalAudio)
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/search",
+					mock.MatchedBy(func(queryParams *model.QueryParams) bool {
+						params := *queryParams
+						return params["q"].String() == tt.query.String() &&
+							params["type"].String() == "album,track" &&
+							params["limit"] == tt.limit &&
+							params["offset"] == tt.offset &&
+							params["include_external"] == includeExternal
+					}),
+					mock.Anything, mock.Anything, nil, mock.AnythingOfType("*model.SearchResult")).
+					Run(func(args mock.Arguments) {
+						output := args.Get(7).(*model.SearchResult)
+						output.Albums = &model.SimplifiedAlbumsPaginated{Items: []model.SimplifiedAlbum{{ID: "album-1"}}}
+					}).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifySearchResource(httpClient, "https://api.spotify.com")
+			got, err := r.Search(context.Background(), "token", tt.query, tt.searchTypes, nil, tt.limit, tt.offset, includeExternal)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Search() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequest && (got.Albums == nil || got.Albums.Items[0].ID != "album-1" || got.Tracks != nil) {
+				t.Errorf("Search() = %+v, want only the albums answered", got)
+			}
+		})
+	}
+}
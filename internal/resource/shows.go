@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/utils"
+)
+
+type SpotifyShowsResource struct {
+	httpClient client.HTTPApiClient
+	baseURL    string
+}
+
+func NewSpotifyShowsResource(
+	httpAPIClient client.HTTPApiClient,
+	baseURL string,
+) ShowsResource {
+	return SpotifyShowsResource{
+		httpClient: httpAPIClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (r SpotifyShowsResource) GetShow(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	showID model.ID,
+) (model.Show, error) {
+	url := r.baseURL + APIVersion + ShowsPath + "/" + showID.String()
+	queryParams := &model.QueryParams{
+		"market": market,
+	}
+	output := &model.Show{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.Show{}, fmt.Errorf("error executing show request for show ID - %s - %w", showID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyShowsResource) GetShows(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	showsIDs model.ShowsIDs,
+) ([]model.SimplifiedShow, error) {
+	if err := r.validateShowsIDsSize(showsIDs); err != nil {
+		return []model.SimplifiedShow{}, err
+	}
+
+	url := r.baseURL + APIVersion + ShowsPath
+	queryParams := &model.QueryParams{
+		"ids":    showsIDs,
+		"market": market,
+	}
+	output := &model.MultipleShows{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return []model.SimplifiedShow{}, fmt.Errorf("error executing show request for shows IDs - %s - %w", showsIDs.String(), err)
+	}
+	return output.Shows, nil
+}
+
+func (r SpotifyShowsResource) GetShowEpisodes(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	market *model.AvailableMarket,
+	limit *model.Limit,
+	offset *model.Offset,
+	showID model.ID,
+) (model.SimplifiedEpisodesPaginated, error) {
+	if err := utils.ValidatePaginationParams(limit, offset); err != nil {
+		return model.SimplifiedEpisodesPaginated{}, fmt.Errorf("error creating show episodes request for show ID - %s - %w", showID.String(), err)
+	}
+
+	url := r.baseURL + APIVersion + ShowsPath + "/" + showID.String() + EpisodesPath
+	queryParams := &model.QueryParams{
+		"market": market,
+		"limit":  limit,
+		"offset": offset,
+	}
+	output := &model.SimplifiedEpisodesPaginated{}
+
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
+		return model.SimplifiedEpisodesPaginated{}, fmt.Errorf("error executing show episodes request for show ID - %s - %w", showID.String(), err)
+	}
+	return *output, nil
+}
+
+func (r SpotifyShowsResource) validateShowsIDsSize(showsIDs model.ShowsIDs) error {
+	if len(showsIDs) < 1 {
+		return fmt.Errorf("error getting show - show id must not be null")
+	}
+	if len(showsIDs) > MaxShowsIDs {
+		return fmt.Errorf("error getting show - at most %d show ids are allowed per request, got %d", MaxShowsIDs, len(showsIDs))
+	}
+	return nil
+}
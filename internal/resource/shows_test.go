@@ -0,0 +1,84 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	mocks "jezz-go-spotify-integration/internal/mocks/client"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyShowsResource_GetShows(t *testing.T) {
+	tooManyIDs := make(model.ShowsIDs, MaxShowsIDs+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = model.ID(fmt.Sprintf("show-%d", i))
+	}
+	tests := []struct {
+		name        string
+		showsIDs    model.ShowsIDs
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should get several shows", showsIDs: model.ShowsIDs{"show-1", "show-2"}, wantRequest: true},
+		{name: "should return error when no id is given", showsIDs: model.ShowsIDs{}, wantErr: true},
+		{name: "should return error when more ids than allowed are given", showsIDs: tooManyIDs, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			market := lo.ToPtr(model.AvailableMarket("BR"))
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/shows",
+					&model.QueryParams{"ids": tt.showsIDs, "market": market},
+					client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.MultipleShows")).
+					Run(func(args mock.Arguments) {
+						args.Get(7).(*model.MultipleShows).Shows = []model.SimplifiedShow{{ID: "show-1"}, {ID: "show-2"}}
+					}).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyShowsResource(httpClient, "https://api.spotify.com")
+			got, err := r.GetShows(context.Background(), "token", market, tt.showsIDs)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetShows() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequest && len(got) != 2 {
+				t.Errorf("GetShows() = %+v, want 2 shows", got)
+			}
+		})
+	}
+}
+
+func TestSpotifyShowsResource_GetShowEpisodes(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       *model.Limit
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should get show episodes", limit: lo.ToPtr(model.Limit(10)), wantRequest: true},
+		{name: "should return error when limit is invalid", limit: lo.ToPtr(model.Limit(51)), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := mocks.NewHTTPApiClient(t)
+			if tt.wantRequest {
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/shows/show-1/episodes",
+					mock.Anything, client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.SimplifiedEpisodesPaginated")).
+					Return(nil).Once()
+			}
+
+			r := NewSpotifyShowsResource(httpClient, "https://api.spotify.com")
+			_, err := r.GetShowEpisodes(context.Background(), "token", nil, tt.limit, nil, "show-1")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetShowEpisodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
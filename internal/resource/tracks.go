@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/model"
@@ -22,6 +23,7 @@ func NewSpotifyTracksResource(
 }
 
 func (r SpotifyTracksResource) GetTrack(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	market *model.AvailableMarket,
 	trackID model.ID,
@@ -32,13 +34,14 @@ func (r SpotifyTracksResource) GetTrack(
 	}
 	output := &model.Track{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return model.Track{}, fmt.Errorf("error executing track request for track ID - %s - %w", trackID.String(), err)
 	}
 	return *output, nil
 }
 
 func (r SpotifyTracksResource) GetTracks(
+	ctx context.Context,
 	accessToken model.AccessToken,
 	market *model.AvailableMarket,
 	tracksIDs model.TracksIDs,
@@ -54,7 +57,7 @@ func (r SpotifyTracksResource) GetTracks(
 	}
 	output := &model.MultipleTracks{}
 
-	if err := r.httpClient.DoRequest(model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, output); err != nil {
+	if err := r.httpClient.DoRequest(ctx, model.HTTPGet, url, queryParams, client.ContentTypeJSON, &accessToken, nil, output); err != nil {
 		return []model.Track{}, fmt.Errorf("error executing track request for tracks IDs - %s - %w", tracksIDs.String(), err)
 	}
 	return output.Tracks, nil
@@ -64,5 +67,8 @@ func (r SpotifyTracksResource) validateTracksIDsSize(tracksIDs model.TracksIDs)
 	if len(tracksIDs) < 1 {
 		return fmt.Errorf("error getting track - track id must not be null")
 	}
+	if len(tracksIDs) > MaxTracksIDs {
+		return fmt.Errorf("error getting track - at most %d track ids are allowed per request, got %d", MaxTracksIDs, len(tracksIDs))
+	}
 	return nil
 }
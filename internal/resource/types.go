@@ -1,22 +1,72 @@
 package resource
 
-import "jezz-go-spotify-integration/internal/model"
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/model"
+)
 
 type AlbumsResource interface {
-	GetAlbum(accessToken model.AccessToken, market *model.AvailableMarket, albumID model.ID) (model.Album, error)
-	GetAlbums(accessToken model.AccessToken, market *model.AvailableMarket, albumsIDs model.AlbumsIDs) ([]model.Album, error)
-	GetAlbumTracks(accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, albumID model.ID) (model.SimplifiedTracksPaginated, error)
-	GetNewReleases(accessToken model.AccessToken, limit *model.Limit, offset *model.Offset) (model.AlbumsNewRelease, error)
+	GetAlbum(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, albumID model.ID) (model.Album, error)
+	GetAlbums(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, albumsIDs model.AlbumsIDs) ([]model.Album, error)
+	GetAlbumTracks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, albumID model.ID) (model.SimplifiedTracksPaginated, error)
+	GetNewReleases(ctx context.Context, accessToken model.AccessToken, limit *model.Limit, offset *model.Offset) (model.AlbumsNewRelease, error)
 }
 
 type ArtistsResource interface {
-	GetArtist(accessToken model.AccessToken, artistID model.ID) (model.Artist, error)
-	GetArtists(accessToken model.AccessToken, artistsIDs model.ArtistsIDs) ([]model.Artist, error)
-	GetArtistAlbums(accessToken model.AccessToken, includeGroups *model.AlbumGroups, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, artistID model.ID) (model.SimplifiedArtistAlbumsPaginated, error)
-	GetArtistTopTracks(accessToken model.AccessToken, market *model.AvailableMarket, artistID model.ID) ([]model.Track, error)
+	GetArtist(ctx context.Context, accessToken model.AccessToken, artistID model.ID) (model.Artist, error)
+	GetArtists(ctx context.Context, accessToken model.AccessToken, artistsIDs model.ArtistsIDs) ([]model.Artist, error)
+	GetArtistAlbums(ctx context.Context, accessToken model.AccessToken, includeGroups *model.AlbumGroups, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, artistID model.ID) (model.SimplifiedArtistAlbumsPaginated, error)
+	GetArtistTopTracks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, artistID model.ID) ([]model.Track, error)
+}
+
+type PlaylistsResource interface {
+	GetPlaylist(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, fields *model.Fields, additionalTypes *model.AdditionalTypes, playlistID model.ID) (model.Playlist, error)
+	GetPlaylistItems(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, fields *model.Fields, additionalTypes *model.AdditionalTypes, limit *model.Limit, offset *model.Offset, playlistID model.ID) (model.PlaylistItemsPaginated, error)
+	GetPlaylistCoverImage(ctx context.Context, accessToken model.AccessToken, playlistID model.ID) ([]model.Image, error)
+	CreatePlaylist(ctx context.Context, accessToken model.AccessToken, userID model.ID, details model.PlaylistDetails) (model.Playlist, error)
+	ChangePlaylistDetails(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, details model.PlaylistDetails) error
+	AddPlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.AddPlaylistItemsRequest) (model.SnapshotID, error)
+	ReorderPlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.ReorderPlaylistItemsRequest) (model.SnapshotID, error)
+	ReplacePlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.ReplacePlaylistItemsRequest) (model.SnapshotID, error)
+	RemovePlaylistItems(ctx context.Context, accessToken model.AccessToken, playlistID model.ID, request model.RemovePlaylistItemsRequest) (model.SnapshotID, error)
+}
+
+type SearchResource interface {
+	Search(ctx context.Context, accessToken model.AccessToken, query model.SearchQuery, searchTypes model.SearchTypes, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, includeExternal *model.IncludeExternal) (model.SearchResult, error)
 }
 
 type TracksResource interface {
-	GetTrack(accessToken model.AccessToken, market *model.AvailableMarket, trackID model.ID) (model.Track, error)
-	GetTracks(accessToken model.AccessToken, market *model.AvailableMarket, tracksIDs model.TracksIDs) ([]model.Track, error)
+	GetTrack(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, trackID model.ID) (model.Track, error)
+	GetTracks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, tracksIDs model.TracksIDs) ([]model.Track, error)
+}
+
+type ShowsResource interface {
+	GetShow(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, showID model.ID) (model.Show, error)
+	GetShows(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, showsIDs model.ShowsIDs) ([]model.SimplifiedShow, error)
+	GetShowEpisodes(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, showID model.ID) (model.SimplifiedEpisodesPaginated, error)
+}
+
+type EpisodesResource interface {
+	GetEpisode(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, episodeID model.ID) (model.Episode, error)
+	GetEpisodes(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, episodesIDs model.EpisodesIDs) ([]model.Episode, error)
+}
+
+type AudiobooksResource interface {
+	GetAudiobook(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, audiobookID model.ID) (model.Audiobook, error)
+	GetAudiobooks(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, audiobooksIDs model.AudiobooksIDs) ([]model.Audiobook, error)
+	GetAudiobookChapters(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, limit *model.Limit, offset *model.Offset, audiobookID model.ID) (model.SimplifiedChaptersPaginated, error)
+}
+
+type ChaptersResource interface {
+	GetChapter(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, chapterID model.ID) (model.Chapter, error)
+	GetChapters(ctx context.Context, accessToken model.AccessToken, market *model.AvailableMarket, chaptersIDs model.ChaptersIDs) ([]model.Chapter, error)
+}
+
+type BrowseResource interface {
+	GetCategories(ctx context.Context, accessToken model.AccessToken, locale *model.Locale, limit *model.Limit, offset *model.Offset) (model.CategoriesPaginated, error)
+	GetCategory(ctx context.Context, accessToken model.AccessToken, locale *model.Locale, categoryID model.ID) (model.Category, error)
+}
+
+type MarketsResource interface {
+	GetAvailableMarkets(ctx context.Context, accessToken model.AccessToken) ([]model.AvailableMarket, error)
 }
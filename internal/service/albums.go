@@ -1,7 +1,9 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/model"
 	"jezz-go-spotify-integration/internal/resource"
@@ -12,6 +14,7 @@ import (
 
 type SpotifyAlbumsService struct {
 	authService    AuthService
+	marketResolver *utils.MarketResolver
 	albumsResource resource.AlbumsResource
 }
 
@@ -19,61 +22,71 @@ func NewSpotifyAlbumsService(
 	baseURL string,
 	httpAPIClient client.HTTPApiClient,
 	authService AuthService,
+	marketResolver *utils.MarketResolver,
 ) AlbumsService {
 	return &SpotifyAlbumsService{
 		authService:    authService,
+		marketResolver: marketResolver,
 		albumsResource: resource.NewSpotifyAlbumsResource(httpAPIClient, baseURL),
 	}
 }
 
 func (s *SpotifyAlbumsService) GetAlbum(
+	ctx context.Context,
 	countryMarketName *string,
 	albumID string,
 ) (model.Album, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return model.Album{}, fmt.Errorf("errror getting album for country %s - invalid country name: %w", *countryMarketName, err)
+		return model.Album{}, fmt.Errorf("error getting album for market %s - invalid market: %w", *countryMarketName, err)
 	}
 
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.albumsResource.GetAlbum(accessToken, market, model.ID(albumID))
-	})
-	if errA != nil {
-		return model.Album{}, errA
+	_albumID, err := utils.ParseID(albumID, model.EntityTypeAlbum)
+	if err != nil {
+		return model.Album{}, fmt.Errorf("error getting album - %w", err)
 	}
-	return result.(model.Album), nil
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Album, error) {
+		return s.albumsResource.GetAlbum(ctx, accessToken, market, _albumID)
+	})
 }
 
 func (s *SpotifyAlbumsService) GetAlbums(
+	ctx context.Context,
 	countryMarketName *string,
 	albumsIDs ...string,
 ) ([]model.Album, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return []model.Album{}, fmt.Errorf("errror getting albums for country %s - invalid country name: %w", *countryMarketName, err)
+		return []model.Album{}, fmt.Errorf("error getting albums for market %s - invalid market: %w", *countryMarketName, err)
 	}
 
-	_albumsIDs := lo.Map(albumsIDs, func(albumID string, _ int) model.ID {
-		return model.ID(albumID)
-	})
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.albumsResource.GetAlbums(accessToken, market, _albumsIDs)
-	})
-	if errA != nil {
-		return []model.Album{}, errA
+	_albumsIDs, err := toIDs(albumsIDs, model.EntityTypeAlbum)
+	if err != nil {
+		return []model.Album{}, fmt.Errorf("error getting albums - %w", err)
 	}
-	return result.([]model.Album), nil
+	return utils.FetchInBatches(ctx, "album", _albumsIDs, resource.MaxAlbumsIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.Album, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Album, error) {
+				return s.albumsResource.GetAlbums(ctx, accessToken, market, batchIDs)
+			})
+		},
+		func(album model.Album) bool {
+			return album.ID == ""
+		},
+	)
 }
 
 func (s *SpotifyAlbumsService) GetAlbumTracks(
+	ctx context.Context,
 	countryMarketName *string,
 	limit *int,
 	offset *int,
 	albumID string,
 ) (model.SimplifiedTracksPaginated, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return model.SimplifiedTracksPaginated{}, fmt.Errorf("errror getting album tracks for country %s - invalid country name: %w", *countryMarketName, err)
+		return model.SimplifiedTracksPaginated{}, fmt.Errorf("error getting album tracks for market %s - invalid market: %w", *countryMarketName, err)
 	}
 
 	var _limit *model.Limit
@@ -85,17 +98,18 @@ func (s *SpotifyAlbumsService) GetAlbumTracks(
 		_offset = lo.ToPtr(model.Offset(*offset))
 	}
 
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.albumsResource.GetAlbumTracks(accessToken, market, _limit, _offset, model.ID(albumID))
-	})
-	if errA != nil {
-		return model.SimplifiedTracksPaginated{}, errA
+	_albumID, err := utils.ParseID(albumID, model.EntityTypeAlbum)
+	if err != nil {
+		return model.SimplifiedTracksPaginated{}, fmt.Errorf("error getting album tracks - %w", err)
 	}
-	return result.(model.SimplifiedTracksPaginated), nil
 
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SimplifiedTracksPaginated, error) {
+		return s.albumsResource.GetAlbumTracks(ctx, accessToken, market, _limit, _offset, _albumID)
+	})
 }
 
 func (s *SpotifyAlbumsService) GetNewReleases(
+	ctx context.Context,
 	limit *int,
 	offset *int,
 ) (model.AlbumsNewRelease, error) {
@@ -108,11 +122,43 @@ func (s *SpotifyAlbumsService) GetNewReleases(
 		_offset = lo.ToPtr(model.Offset(*offset))
 	}
 
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.albumsResource.GetNewReleases(accessToken, _limit, _offset)
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.AlbumsNewRelease, error) {
+		return s.albumsResource.GetNewReleases(ctx, accessToken, _limit, _offset)
 	})
-	if errA != nil {
-		return model.AlbumsNewRelease{}, errA
-	}
-	return result.(model.AlbumsNewRelease), nil
+}
+
+// AlbumTracksSeq iterates over every track of the album, fetching its pages on demand. maxItems caps the number of
+// tracks when greater than 0.
+func (s *SpotifyAlbumsService) AlbumTracksSeq(
+	ctx context.Context,
+	countryMarketName *string,
+	maxItems int,
+	albumID string,
+) iter.Seq2[model.SimplifiedTrack, error] {
+	return utils.Paginate(func(limit model.Limit, offset model.Offset) ([]model.SimplifiedTrack, model.Pagination, error) {
+		page, err := s.GetAlbumTracks(ctx, countryMarketName, lo.ToPtr(limit.Int()), lo.ToPtr(offset.Int()), albumID)
+		return page.Items, page.Pagination, err
+	}, maxItems)
+}
+
+func (s *SpotifyAlbumsService) GetAllAlbumTracks(
+	ctx context.Context,
+	countryMarketName *string,
+	maxItems int,
+	albumID string,
+) ([]model.SimplifiedTrack, error) {
+	return utils.CollectAll(s.AlbumTracksSeq(ctx, countryMarketName, maxItems, albumID))
+}
+
+// NewReleasesSeq iterates over every new release, fetching its pages on demand. maxItems caps the number of
+// albums when greater than 0.
+func (s *SpotifyAlbumsService) NewReleasesSeq(ctx context.Context, maxItems int) iter.Seq2[model.SimplifiedAlbum, error] {
+	return utils.Paginate(func(limit model.Limit, offset model.Offset) ([]model.SimplifiedAlbum, model.Pagination, error) {
+		page, err := s.GetNewReleases(ctx, lo.ToPtr(limit.Int()), lo.ToPtr(offset.Int()))
+		return page.Albums.Items, page.Albums.Pagination, err
+	}, maxItems)
+}
+
+func (s *SpotifyAlbumsService) GetAllNewReleases(ctx context.Context, maxItems int) ([]model.SimplifiedAlbum, error) {
+	return utils.CollectAll(s.NewReleasesSeq(ctx, maxItems))
 }
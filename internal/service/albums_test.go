@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	authmocks "jezz-go-spotify-integration/internal/mocks/auth"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyAlbumsService_GetAlbums(t *testing.T) {
+	ids := make([]string, 45)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("album%017d", i)
+	}
+
+	authFlow := authmocks.NewAuthenticationFlow(t)
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+	authService, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+
+	albumsResource := resourcemocks.NewAlbumsResource(t)
+	albumsResource.On("GetAlbums", mock.Anything, model.AccessToken("token-1"), (*model.AvailableMarket)(nil),
+		mock.MatchedBy(func(batchIDs model.AlbumsIDs) bool {
+			return len(batchIDs) <= resource.MaxAlbumsIDs
+		})).
+		Return(func(_ context.Context, _ model.AccessToken, _ *model.AvailableMarket, batchIDs model.AlbumsIDs) ([]model.Album, error) {
+			albums := make([]model.Album, len(batchIDs))
+			for i, id := range batchIDs {
+				if id != model.ID(ids[21]) {
+					albums[i].ID = id
+				}
+			}
+			return albums, nil
+		}).Times(3)
+
+	s := &SpotifyAlbumsService{authService: authService, albumsResource: albumsResource}
+	got, err := s.GetAlbums(context.Background(), nil, ids...)
+
+	var missingErr commons.MissingIDsError
+	if !errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.IDs, []string{ids[21]}) {
+		t.Fatalf("GetAlbums() error = %v, want %s reported as missing", err, ids[21])
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("GetAlbums() returned %d albums, want %d", len(got), len(ids))
+	}
+	for i, id := range ids {
+		want := model.ID(id)
+		if i == 21 {
+			want = ""
+		}
+		if got[i].ID != want {
+			t.Errorf("GetAlbums()[%d].ID = %q, want %q", i, got[i].ID, want)
+		}
+	}
+}
+
+func TestSpotifyAlbumsService_GetAlbum_Market(t *testing.T) {
+	tests := []struct {
+		name              string
+		countryMarketName string
+		wantMarket        model.AvailableMarket
+		wantErr           bool
+	}{
+		{name: "should resolve alpha3 code into the market", countryMarketName: "bra", wantMarket: "BR"},
+		{name: "should pass from_token through", countryMarketName: "from_token", wantMarket: "from_token"},
+		{name: "should not request markets spotify does not serve", countryMarketName: "Argentina", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			albumsResource := resourcemocks.NewAlbumsResource(t)
+			authFlow := authmocks.NewAuthenticationFlow(t)
+			s := &SpotifyAlbumsService{
+				albumsResource: albumsResource,
+				marketResolver: utils.NewMarketResolver([]model.AvailableMarket{"BR"}),
+			}
+			if !tt.wantErr {
+				authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+				authService, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+				if err != nil {
+					t.Fatalf("NewSpotifyAuthService() error = %v", err)
+				}
+				s.authService = authService
+				albumsResource.On("GetAlbum", mock.Anything, model.AccessToken("token-1"), &tt.wantMarket, model.ID("4aawyAB9vmqN3uQ7FjRGTy")).
+					Return(model.Album{}, nil).Once()
+			}
+
+			_, err := s.GetAlbum(context.Background(), &tt.countryMarketName, "4aawyAB9vmqN3uQ7FjRGTy")
+
+			if tt.wantErr != errors.Is(err, commons.ErrInvalidMarket) {
+				t.Errorf("GetAlbum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpotifyAlbumsService_GetAlbum_ErrorChain(t *testing.T) {
+	authFlow := authmocks.NewAuthenticationFlow(t)
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+	authService, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+	resErr := &commons.ResourceError{Status: 404, Message: "Non existing id", Endpoint: "GET /v1/albums/4aawyAB9vmqN3uQ7FjRGTy", RequestID: "request-1"}
+	albumsResource := resourcemocks.NewAlbumsResource(t)
+	albumsResource.On("GetAlbum", mock.Anything, model.AccessToken("token-1"), (*model.AvailableMarket)(nil), model.ID("4aawyAB9vmqN3uQ7FjRGTy")).
+		Return(model.Album{}, fmt.Errorf("error executing album request for album ID - 4aawyAB9vmqN3uQ7FjRGTy - %w", resErr)).Once()
+
+	s := &SpotifyAlbumsService{authService: authService, albumsResource: albumsResource}
+	_, err = s.GetAlbum(context.Background(), nil, "4aawyAB9vmqN3uQ7FjRGTy")
+
+	if !errors.Is(err, commons.ErrNotFound) || commons.IsRetryable(err) {
+		t.Fatalf("GetAlbum() error = %v, want non retryable %v", err, commons.ErrNotFound)
+	}
+	var gotErr *commons.ResourceError
+	if !errors.As(err, &gotErr) || gotErr.RequestID != "request-1" {
+		t.Errorf("GetAlbum() error = %v, want the resource error with its request id", err)
+	}
+}
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/model"
 	"jezz-go-spotify-integration/internal/resource"
@@ -12,6 +14,7 @@ import (
 
 type SpotifyArtistsService struct {
 	authService     AuthService
+	marketResolver  *utils.MarketResolver
 	artistsResource resource.ArtistsResource
 }
 
@@ -19,46 +22,54 @@ func NewSpotifyArtistsService(
 	baseURL string,
 	httpAPIClient client.HTTPApiClient,
 	authService AuthService,
+	marketResolver *utils.MarketResolver,
 ) ArtistsService {
 	return &SpotifyArtistsService{
 		authService:     authService,
+		marketResolver:  marketResolver,
 		artistsResource: resource.NewSpotifyArtistsResource(httpAPIClient, baseURL),
 	}
 }
 
-func (s *SpotifyArtistsService) GetArtist(artistID string) (model.Artist, error) {
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.artistsResource.GetArtist(accessToken, model.ID(artistID))
-	})
-	if errA != nil {
-		return model.Artist{}, errA
+func (s *SpotifyArtistsService) GetArtist(ctx context.Context, artistID string) (model.Artist, error) {
+	_artistID, err := utils.ParseID(artistID, model.EntityTypeArtist)
+	if err != nil {
+		return model.Artist{}, fmt.Errorf("error getting artist - %w", err)
 	}
-	return result.(model.Artist), nil
-}
 
-func (s *SpotifyArtistsService) GetArtists(artistIDsStr ...string) ([]model.Artist, error) {
-	artistsIDs := lo.Map(artistIDsStr, func(artistID string, _ int) model.ID {
-		return model.ID(artistID)
-	})
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.artistsResource.GetArtists(accessToken, artistsIDs)
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Artist, error) {
+		return s.artistsResource.GetArtist(ctx, accessToken, _artistID)
 	})
-	if errA != nil {
-		return []model.Artist{}, errA
+}
+
+func (s *SpotifyArtistsService) GetArtists(ctx context.Context, artistIDsStr ...string) ([]model.Artist, error) {
+	artistsIDs, err := toIDs(artistIDsStr, model.EntityTypeArtist)
+	if err != nil {
+		return []model.Artist{}, fmt.Errorf("error getting artists - %w", err)
 	}
-	return result.([]model.Artist), nil
+	return utils.FetchInBatches(ctx, "artist", artistsIDs, resource.MaxArtistsIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.Artist, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Artist, error) {
+				return s.artistsResource.GetArtists(ctx, accessToken, batchIDs)
+			})
+		},
+		func(artist model.Artist) bool {
+			return artist.ID == ""
+		},
+	)
 }
 
 func (s *SpotifyArtistsService) GetArtistAlbums(
+	ctx context.Context,
 	countryMarketName *string,
 	albumTypes *[]string,
 	limit *int,
 	offset *int,
-	albumID string,
+	artistID string,
 ) (model.SimplifiedArtistAlbumsPaginated, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return model.SimplifiedArtistAlbumsPaginated{}, fmt.Errorf("errror getting album tracks for country %s - invalid country name: %w", *countryMarketName, err)
+		return model.SimplifiedArtistAlbumsPaginated{}, fmt.Errorf("error getting artist albums for market %s - invalid market: %w", *countryMarketName, err)
 	}
 
 	var _limit *model.Limit
@@ -78,29 +89,57 @@ func (s *SpotifyArtistsService) GetArtistAlbums(
 		}
 	}
 
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.artistsResource.GetArtistAlbums(accessToken, includeGroups, market, _limit, _offset, model.ID(albumID))
-	})
-	if errA != nil {
-		return model.SimplifiedArtistAlbumsPaginated{}, errA
+	_artistID, err := utils.ParseID(artistID, model.EntityTypeArtist)
+	if err != nil {
+		return model.SimplifiedArtistAlbumsPaginated{}, fmt.Errorf("error getting artist albums - %w", err)
 	}
-	return result.(model.SimplifiedArtistAlbumsPaginated), nil
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SimplifiedArtistAlbumsPaginated, error) {
+		return s.artistsResource.GetArtistAlbums(ctx, accessToken, includeGroups, market, _limit, _offset, _artistID)
+	})
 }
 
 func (s *SpotifyArtistsService) GetArtistTopTracks(
+	ctx context.Context,
 	countryMarketName *string,
 	artistID string,
 ) ([]model.Track, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return []model.Track{}, fmt.Errorf("errror getting artist top-tracks for country %s - invalid country name: %w", *countryMarketName, err)
+		return []model.Track{}, fmt.Errorf("error getting artist top-tracks for market %s - invalid market: %w", *countryMarketName, err)
 	}
 
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.artistsResource.GetArtistTopTracks(accessToken, market, model.ID(artistID))
-	})
-	if errA != nil {
-		return []model.Track{}, errA
+	_artistID, err := utils.ParseID(artistID, model.EntityTypeArtist)
+	if err != nil {
+		return []model.Track{}, fmt.Errorf("error getting artist top tracks - %w", err)
 	}
-	return result.([]model.Track), nil
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Track, error) {
+		return s.artistsResource.GetArtistTopTracks(ctx, accessToken, market, _artistID)
+	})
+}
+
+// ArtistAlbumsSeq iterates over every album of the artist, fetching its pages on demand. maxItems caps the number
+// of albums when greater than 0.
+func (s *SpotifyArtistsService) ArtistAlbumsSeq(
+	ctx context.Context,
+	countryMarketName *string,
+	albumTypes *[]string,
+	maxItems int,
+	artistID string,
+) iter.Seq2[model.SimplifiedArtistAlbum, error] {
+	return utils.Paginate(func(limit model.Limit, offset model.Offset) ([]model.SimplifiedArtistAlbum, model.Pagination, error) {
+		page, err := s.GetArtistAlbums(ctx, countryMarketName, albumTypes, lo.ToPtr(limit.Int()), lo.ToPtr(offset.Int()), artistID)
+		return page.Items, page.Pagination, err
+	}, maxItems)
+}
+
+func (s *SpotifyArtistsService) GetAllArtistAlbums(
+	ctx context.Context,
+	countryMarketName *string,
+	albumTypes *[]string,
+	maxItems int,
+	artistID string,
+) ([]model.SimplifiedArtistAlbum, error) {
+	return utils.CollectAll(s.ArtistAlbumsSeq(ctx, countryMarketName, albumTypes, maxItems, artistID))
 }
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+
+	"github.com/samber/lo"
+)
+
+type SpotifyAudiobooksService struct {
+	authService        AuthService
+	marketResolver     *utils.MarketResolver
+	audiobooksResource resource.AudiobooksResource
+}
+
+func NewSpotifyAudiobooksService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+	marketResolver *utils.MarketResolver,
+) AudiobooksService {
+	return &SpotifyAudiobooksService{
+		authService:        authService,
+		marketResolver:     marketResolver,
+		audiobooksResource: resource.NewSpotifyAudiobooksResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyAudiobooksService) GetAudiobook(
+	ctx context.Context,
+	countryMarketName *string,
+	audiobookID string,
+) (model.Audiobook, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.Audiobook{}, fmt.Errorf("error getting audiobook for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_audiobookID, err := utils.ParseID(audiobookID, model.EntityTypeAudiobook)
+	if err != nil {
+		return model.Audiobook{}, fmt.Errorf("error getting audiobook - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Audiobook, error) {
+		return s.audiobooksResource.GetAudiobook(ctx, accessToken, market, _audiobookID)
+	})
+}
+
+func (s *SpotifyAudiobooksService) GetAudiobooks(
+	ctx context.Context,
+	countryMarketName *string,
+	audiobooksIDs ...string,
+) ([]model.Audiobook, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return []model.Audiobook{}, fmt.Errorf("error getting audiobooks for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_audiobooksIDs, err := toIDs(audiobooksIDs, model.EntityTypeAudiobook)
+	if err != nil {
+		return []model.Audiobook{}, fmt.Errorf("error getting audiobooks - %w", err)
+	}
+	return utils.FetchInBatches(ctx, "audiobook", _audiobooksIDs, resource.MaxAudiobooksIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.Audiobook, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Audiobook, error) {
+				return s.audiobooksResource.GetAudiobooks(ctx, accessToken, market, batchIDs)
+			})
+		},
+		func(audiobook model.Audiobook) bool {
+			return audiobook.ID == ""
+		},
+	)
+}
+
+func (s *SpotifyAudiobooksService) GetAudiobookChapters(
+	ctx context.Context,
+	countryMarketName *string,
+	limit *int,
+	offset *int,
+	audiobookID string,
+) (model.SimplifiedChaptersPaginated, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.SimplifiedChaptersPaginated{}, fmt.Errorf("error getting audiobook chapters for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	var _limit *model.Limit
+	if limit != nil {
+		_limit = lo.ToPtr(model.Limit(*limit))
+	}
+	var _offset *model.Offset
+	if offset != nil {
+		_offset = lo.ToPtr(model.Offset(*offset))
+	}
+
+	_audiobookID, err := utils.ParseID(audiobookID, model.EntityTypeAudiobook)
+	if err != nil {
+		return model.SimplifiedChaptersPaginated{}, fmt.Errorf("error getting audiobook chapters - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SimplifiedChaptersPaginated, error) {
+		return s.audiobooksResource.GetAudiobookChapters(ctx, accessToken, market, _limit, _offset, _audiobookID)
+	})
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/commons"
+	clientmocks "jezz-go-spotify-integration/internal/mocks/client"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyAudiobooksService_GetAudiobooks(t *testing.T) {
+	ids := newTestIDs("audiobook", 110)
+	missing := []string{ids[7], ids[64]}
+
+	audiobooksResource := resourcemocks.NewAudiobooksResource(t)
+	audiobooksResource.On("GetAudiobooks", mock.Anything, model.AccessToken("token-1"), lo.ToPtr(model.AvailableMarket("BR")),
+		mock.MatchedBy(func(batchIDs model.AudiobooksIDs) bool {
+			return len(batchIDs) <= resource.MaxAudiobooksIDs
+		})).
+		Return(func(_ context.Context, _ model.AccessToken, _ *model.AvailableMarket, batchIDs model.AudiobooksIDs) ([]model.Audiobook, error) {
+			audiobooks := make([]model.Audiobook, len(batchIDs))
+			for i, id := range batchIDs {
+				if !lo.Contains(missing, id.String()) {
+					audiobooks[i].ID = id
+				}
+			}
+			return audiobooks, nil
+		}).Times(3)
+
+	s := &SpotifyAudiobooksService{
+		authService:        newTestAuthService(t),
+		marketResolver:     utils.NewMarketResolver([]model.AvailableMarket{"BR"}),
+		audiobooksResource: audiobooksResource,
+	}
+	got, err := s.GetAudiobooks(context.Background(), lo.ToPtr("bra"), ids...)
+
+	var missingErr commons.MissingIDsError
+	if !errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.IDs, missing) {
+		t.Fatalf("GetAudiobooks() error = %v, want %v reported as missing", err, missing)
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("GetAudiobooks() returned %d audiobooks, want %d", len(got), len(ids))
+	}
+	for i, id := range ids {
+		want := model.ID(id)
+		if lo.Contains(missing, id) {
+			want = ""
+		}
+		if got[i].ID != want {
+			t.Errorf("GetAudiobooks()[%d].ID = %q, want %q", i, got[i].ID, want)
+		}
+	}
+}
+
+func TestSpotifyAudiobooksService_GetAudiobookChapters(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       *int
+		offset      *int
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should request the page given by limit and offset", limit: lo.ToPtr(50), offset: lo.ToPtr(100), wantRequest: true},
+		{name: "should request the first page without limit and offset", wantRequest: true},
+		{name: "should return error when limit is above the maximum", limit: lo.ToPtr(51), wantErr: true},
+		{name: "should return error when offset is negative", offset: lo.ToPtr(-1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := clientmocks.NewHTTPApiClient(t)
+			if tt.wantRequest {
+				wantParams := &model.QueryParams{"market": (*model.AvailableMarket)(nil), "limit": (*model.Limit)(nil), "offset": (*model.Offset)(nil)}
+				if tt.limit != nil {
+					(*wantParams)["limit"] = lo.ToPtr(model.Limit(*tt.limit))
+				}
+				if tt.offset != nil {
+					(*wantParams)["offset"] = lo.ToPtr(model.Offset(*tt.offset))
+				}
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/audiobooks/7iHfbu1YPACw6oZPAFJtqe/chapters",
+					wantParams, client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.SimplifiedChaptersPaginated")).
+					Return(nil).Once()
+			}
+
+			s := &SpotifyAudiobooksService{
+				authService:        newTestAuthService(t),
+				audiobooksResource: resource.NewSpotifyAudiobooksResource(httpClient, "https://api.spotify.com"),
+			}
+			_, err := s.GetAudiobookChapters(context.Background(), nil, tt.limit, tt.offset, "7iHfbu1YPACw6oZPAFJtqe")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetAudiobookChapters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
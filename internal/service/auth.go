@@ -1,56 +1,153 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"jezz-go-spotify-integration/internal/auth"
 	"jezz-go-spotify-integration/internal/commons"
 	"jezz-go-spotify-integration/internal/model"
+	"sync"
+	"time"
 )
 
+var (
+	// for testing purposes
+	timeNow = time.Now
+)
+
+// SpotifyAuthService is safe for concurrent use. Refreshes are serialized, so when several callers find out the
+// token is no longer valid at the same time only the first one re-authenticates and the others reuse its token.
 type SpotifyAuthService struct {
-	appAuth  *model.Authentication
-	authFlow auth.AuthenticationFlow
+	mu             sync.Mutex
+	appAuth        *model.Authentication
+	authObtainedAt time.Time
+	authFlow       auth.AuthenticationFlow
+	refreshSkew    time.Duration
 }
 
+// NewSpotifyAuthService authenticates right away and keeps the obtained token cached. The token is refreshed
+// proactively once its remaining lifetime is within refreshSkew, and reactively when the API answers 401/403.
 func NewSpotifyAuthService(
+	ctx context.Context,
 	authFlow auth.AuthenticationFlow,
+	refreshSkew time.Duration,
 ) (*SpotifyAuthService, error) {
-	authentication, err := authFlow.Authenticate()
-	if err != nil {
+	s := &SpotifyAuthService{
+		authFlow:    authFlow,
+		refreshSkew: refreshSkew,
+	}
+	if err := s.authenticate(ctx); err != nil {
 		return nil, err
 	}
-	return &SpotifyAuthService{
-		appAuth:  authentication,
-		authFlow: authFlow,
-	}, nil
+	return s, nil
 }
 
-func (s *SpotifyAuthService) ExecuteWithAuthentication(fn ExecuteWithAuthenticationFn) (any, error) {
-	t, err := s.authAndExecute(false, fn)
+func (s *SpotifyAuthService) ExecuteWithAuthentication(ctx context.Context, fn ExecuteWithAuthenticationFn) (any, error) {
+	accessToken, err := s.currentToken(ctx)
 	if err != nil {
-		apiErr := commons.ResourceError{}
-		if errors.As(err, &apiErr) && apiErr.Status == 401 || apiErr.Status == 403 {
-			return s.authAndExecute(true, fn)
+		return nil, err
+	}
+	t, err := fn(accessToken)
+	if err != nil && isAuthenticationFailure(err) {
+		accessToken, err = s.refreshToken(ctx, accessToken)
+		if err != nil {
+			return nil, err
 		}
+		return fn(accessToken)
 	}
 	return t, err
 }
 
-func (s *SpotifyAuthService) authenticate() error {
-	authSession, err := s.authFlow.Authenticate()
+// Execute runs fn through authService.ExecuteWithAuthentication, keeping its re-authentication behavior, and
+// returns fn's result with its own type instead of any. The result is captured from fn itself, so no type
+// assertion is needed and the latest attempt is the one returned when fn is retried after a re-authentication.
+func Execute[T any](
+	ctx context.Context,
+	authService AuthService,
+	fn func(accessToken model.AccessToken) (T, error),
+) (T, error) {
+	var result T
+	_, err := authService.ExecuteWithAuthentication(ctx, func(accessToken model.AccessToken) (any, error) {
+		var fnErr error
+		result, fnErr = fn(accessToken)
+		return nil, fnErr
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// TokenRemainingLifetime returns how long the cached token is still valid for, or zero when there is no token,
+// it has already expired or the authentication flow did not report its lifetime.
+func (s *SpotifyAuthService) TokenRemainingLifetime() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.remainingLifetime()
+}
+
+func (s *SpotifyAuthService) remainingLifetime() time.Duration {
+	if s.appAuth == nil || s.appAuth.ExpiresIn <= 0 {
+		return 0
+	}
+	expiresAt := s.authObtainedAt.Add(time.Duration(s.appAuth.ExpiresIn) * time.Second)
+	return max(expiresAt.Sub(timeNow()), 0)
+}
+
+func (s *SpotifyAuthService) tokenNeedsRefresh() bool {
+	if s.appAuth == nil {
+		return true
+	}
+	if s.appAuth.ExpiresIn <= 0 {
+		return false
+	}
+	return s.remainingLifetime() <= s.refreshSkew
+}
+
+// authenticate renews the token with the refresh token when the flow supports it, otherwise it runs the whole flow again.
+func (s *SpotifyAuthService) authenticate(ctx context.Context) error {
+	obtainedAt := timeNow()
+	var authSession *model.Authentication
+	var err error
+	if refreshableFlow, ok := s.authFlow.(auth.RefreshableAuthenticationFlow); ok && s.appAuth != nil && s.appAuth.RefreshToken != "" {
+		authSession, err = refreshableFlow.Refresh(ctx, s.appAuth.RefreshToken)
+	} else {
+		authSession, err = s.authFlow.Authenticate(ctx)
+	}
 	if err != nil {
 		return err
 	}
 	s.appAuth = authSession
+	s.authObtainedAt = obtainedAt
 	return nil
 }
 
-func (s *SpotifyAuthService) authAndExecute(forceAuth bool, fn ExecuteWithAuthenticationFn) (any, error) {
-	if forceAuth || s.appAuth == nil {
-		err := s.authenticate()
-		if err != nil {
-			return nil, err
+func (s *SpotifyAuthService) currentToken(ctx context.Context) (model.AccessToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokenNeedsRefresh() {
+		if err := s.authenticate(ctx); err != nil {
+			return "", err
 		}
 	}
-	return fn(s.appAuth.AccessToken)
+	return s.appAuth.AccessToken, nil
+}
+
+// refreshToken re-authenticates after staleToken was rejected by the API, unless a concurrent caller already
+// replaced it while this one was waiting for the lock.
+func (s *SpotifyAuthService) refreshToken(ctx context.Context, staleToken model.AccessToken) (model.AccessToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.appAuth != nil && s.appAuth.AccessToken != staleToken && !s.tokenNeedsRefresh() {
+		return s.appAuth.AccessToken, nil
+	}
+	if err := s.authenticate(ctx); err != nil {
+		return "", err
+	}
+	return s.appAuth.AccessToken, nil
+}
+
+func isAuthenticationFailure(err error) bool {
+	return errors.Is(err, commons.ErrUnauthorized) || errors.Is(err, commons.ErrForbidden)
 }
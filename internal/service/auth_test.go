@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	mocks "jezz-go-spotify-integration/internal/mocks/auth"
+	"jezz-go-spotify-integration/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func mockClock(t *testing.T) *time.Time {
+	t.Helper()
+	originalTimeNow := timeNow
+	t.Cleanup(func() {
+		timeNow = originalTimeNow
+	})
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return now
+	}
+	return &now
+}
+
+func newAuthentication(token string, expiresIn int) *model.Authentication {
+	return &model.Authentication{
+		AccessToken: model.AccessToken(token),
+		TokenType:   "Bearer",
+		ExpiresIn:   expiresIn,
+	}
+}
+
+// newTestAuthService returns an AuthService that authenticates once, with the access token "token-1".
+func newTestAuthService(t *testing.T) AuthService {
+	t.Helper()
+	authFlow := mocks.NewAuthenticationFlow(t)
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+	authService, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+	return authService
+}
+
+func TestNewSpotifyAuthService(t *testing.T) {
+	tests := []struct {
+		name    string
+		authErr error
+		wantErr bool
+	}{
+		{
+			name: "should authenticate when creating the service",
+		},
+		{
+			name:    "should return error when authentication fails",
+			authErr: errors.New("mock auth error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authFlow := mocks.NewAuthenticationFlow(t)
+			if tt.authErr != nil {
+				authFlow.On("Authenticate", mock.Anything).Return(nil, tt.authErr).Once()
+			} else {
+				authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+			}
+
+			got, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSpotifyAuthService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.appAuth.AccessToken != "token-1" {
+				t.Errorf("NewSpotifyAuthService() token = %v, want token-1", got.appAuth.AccessToken)
+			}
+		})
+	}
+}
+
+func TestSpotifyAuthService_ExecuteWithAuthentication(t *testing.T) {
+	tests := []struct {
+		name       string
+		elapsed    time.Duration
+		fnErrs     []error
+		wantTokens []model.AccessToken
+		wantAuths  int
+		wantErr    bool
+	}{
+		{
+			name:       "should reuse cached token while it is far from expiring",
+			elapsed:    30 * time.Minute,
+			fnErrs:     []error{nil},
+			wantTokens: []model.AccessToken{"token-1"},
+			wantAuths:  1,
+		},
+		{
+			name:       "should refresh token proactively when it is within the refresh skew",
+			elapsed:    time.Hour - 30*time.Second,
+			fnErrs:     []error{nil},
+			wantTokens: []model.AccessToken{"token-2"},
+			wantAuths:  2,
+		},
+		{
+			name:       "should refresh token proactively when it is already expired",
+			elapsed:    2 * time.Hour,
+			fnErrs:     []error{nil},
+			wantTokens: []model.AccessToken{"token-2"},
+			wantAuths:  2,
+		},
+		{
+			name:       "should re-authenticate and retry once when api answers unauthorized",
+			fnErrs:     []error{&commons.ResourceError{Status: 401, Message: "The access token expired"}, nil},
+			wantTokens: []model.AccessToken{"token-1", "token-2"},
+			wantAuths:  2,
+		},
+		{
+			name:       "should re-authenticate and retry once when api answers forbidden",
+			fnErrs:     []error{commons.ResourceError{Status: 403, Message: "Forbidden"}, nil},
+			wantTokens: []model.AccessToken{"token-1", "token-2"},
+			wantAuths:  2,
+		},
+		{
+			name:       "should not re-authenticate on other errors",
+			fnErrs:     []error{&commons.ResourceError{Status: 404, Message: "Not found"}},
+			wantTokens: []model.AccessToken{"token-1"},
+			wantAuths:  1,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := mockClock(t)
+			authFlow := mocks.NewAuthenticationFlow(t)
+			authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+			if tt.wantAuths > 1 {
+				authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-2", 3600), nil).Once()
+			}
+
+			s, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+			if err != nil {
+				t.Fatalf("NewSpotifyAuthService() error = %v", err)
+			}
+			*now = now.Add(tt.elapsed)
+
+			var gotTokens []model.AccessToken
+			_, err = s.ExecuteWithAuthentication(context.Background(), func(accessToken model.AccessToken) (any, error) {
+				gotTokens = append(gotTokens, accessToken)
+				return nil, tt.fnErrs[len(gotTokens)-1]
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExecuteWithAuthentication() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(gotTokens) != len(tt.wantTokens) {
+				t.Fatalf("ExecuteWithAuthentication() tokens = %v, want %v", gotTokens, tt.wantTokens)
+			}
+			for i := range tt.wantTokens {
+				if gotTokens[i] != tt.wantTokens[i] {
+					t.Errorf("ExecuteWithAuthentication() token[%d] = %v, want %v", i, gotTokens[i], tt.wantTokens[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSpotifyAuthService_TokenRemainingLifetime(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresIn int
+		elapsed   time.Duration
+		want      time.Duration
+	}{
+		{name: "should return full lifetime right after authenticating", expiresIn: 3600, want: time.Hour},
+		{name: "should discount elapsed time", expiresIn: 3600, elapsed: 45 * time.Minute, want: 15 * time.Minute},
+		{name: "should return zero when token expired", expiresIn: 3600, elapsed: 2 * time.Hour, want: 0},
+		{name: "should return zero when lifetime is unknown", expiresIn: 0, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := mockClock(t)
+			authFlow := mocks.NewAuthenticationFlow(t)
+			authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", tt.expiresIn), nil).Once()
+
+			s, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+			if err != nil {
+				t.Fatalf("NewSpotifyAuthService() error = %v", err)
+			}
+			*now = now.Add(tt.elapsed)
+
+			if got := s.TokenRemainingLifetime(); got != tt.want {
+				t.Errorf("TokenRemainingLifetime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpotifyAuthService_ExecuteWithAuthentication_ConcurrentRefresh(t *testing.T) {
+	const callers = 50
+	authFlow := mocks.NewAuthenticationFlow(t)
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-2", 3600), nil).After(20 * time.Millisecond).Once()
+
+	s, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+
+	start := make(chan struct{})
+	results := make(chan any, callers)
+	errs := make(chan error, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			result, err := s.ExecuteWithAuthentication(context.Background(), func(accessToken model.AccessToken) (any, error) {
+				if accessToken == "token-1" {
+					return nil, &commons.ResourceError{Status: 401, Message: "The access token expired"}
+				}
+				return accessToken, nil
+			})
+			results <- result
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(results)
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("ExecuteWithAuthentication() error = %v, want nil", err)
+		}
+	}
+	for result := range results {
+		if result != model.AccessToken("token-2") {
+			t.Errorf("ExecuteWithAuthentication() result = %v, want token-2", result)
+		}
+	}
+	authFlow.AssertNumberOfCalls(t, "Authenticate", 2)
+}
+
+func TestSpotifyAuthService_ExecuteWithAuthentication_UsesRefreshToken(t *testing.T) {
+	authFlow := mocks.NewRefreshableAuthenticationFlow(t)
+	userAuth := newAuthentication("token-1", 3600)
+	userAuth.RefreshToken = "refresh-token-1"
+	authFlow.On("Authenticate", mock.Anything).Return(userAuth, nil).Once()
+	authFlow.On("Refresh", mock.Anything, model.RefreshToken("refresh-token-1")).Return(newAuthentication("token-2", 3600), nil).Once()
+
+	s, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+
+	result, err := s.ExecuteWithAuthentication(context.Background(), func(accessToken model.AccessToken) (any, error) {
+		if accessToken == "token-1" {
+			return nil, &commons.ResourceError{Status: 401, Message: "The access token expired"}
+		}
+		return accessToken, nil
+	})
+	if err != nil {
+		t.Fatalf("ExecuteWithAuthentication() error = %v", err)
+	}
+	if result != model.AccessToken("token-2") {
+		t.Errorf("ExecuteWithAuthentication() result = %v, want token-2", result)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name      string
+		fnErrs    []error
+		want      []model.SimplifiedAlbum
+		wantAuths int
+		wantErr   bool
+	}{
+		{
+			name:      "should return the typed result",
+			fnErrs:    []error{nil},
+			want:      []model.SimplifiedAlbum{{ID: "album-token-1"}},
+			wantAuths: 1,
+		},
+		{
+			name:      "should return the typed result of the retry after re-authenticating",
+			fnErrs:    []error{&commons.ResourceError{Status: 401, Message: "The access token expired"}, nil},
+			want:      []model.SimplifiedAlbum{{ID: "album-token-2"}},
+			wantAuths: 2,
+		},
+		{
+			name:      "should return zero value on error",
+			fnErrs:    []error{&commons.ResourceError{Status: 404, Message: "Not found"}},
+			wantAuths: 1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authFlow := mocks.NewAuthenticationFlow(t)
+			authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+			if tt.wantAuths > 1 {
+				authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-2", 3600), nil).Once()
+			}
+			s, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+			if err != nil {
+				t.Fatalf("NewSpotifyAuthService() error = %v", err)
+			}
+
+			calls := 0
+			got, err := Execute(context.Background(), s, func(accessToken model.AccessToken) ([]model.SimplifiedAlbum, error) {
+				calls++
+				return []model.SimplifiedAlbum{{ID: model.ID("album-" + accessToken)}}, tt.fnErrs[calls-1]
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Execute() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("Execute()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+				}
+			}
+		})
+	}
+}
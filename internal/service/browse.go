@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"iter"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+
+	"github.com/samber/lo"
+)
+
+type SpotifyBrowseService struct {
+	authService    AuthService
+	browseResource resource.BrowseResource
+}
+
+func NewSpotifyBrowseService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+) BrowseService {
+	return &SpotifyBrowseService{
+		authService:    authService,
+		browseResource: resource.NewSpotifyBrowseResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyBrowseService) GetCategories(
+	ctx context.Context,
+	locale *string,
+	limit *int,
+	offset *int,
+) (model.CategoriesPaginated, error) {
+	var _limit *model.Limit
+	if limit != nil {
+		_limit = lo.ToPtr(model.Limit(*limit))
+	}
+	var _offset *model.Offset
+	if offset != nil {
+		_offset = lo.ToPtr(model.Offset(*offset))
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.CategoriesPaginated, error) {
+		return s.browseResource.GetCategories(ctx, accessToken, toLocale(locale), _limit, _offset)
+	})
+}
+
+func (s *SpotifyBrowseService) GetCategory(
+	ctx context.Context,
+	locale *string,
+	categoryID string,
+) (model.Category, error) {
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Category, error) {
+		return s.browseResource.GetCategory(ctx, accessToken, toLocale(locale), model.ID(categoryID))
+	})
+}
+
+// CategoriesSeq iterates over every browse category, fetching its pages on demand. maxItems caps the number of
+// categories when greater than 0.
+func (s *SpotifyBrowseService) CategoriesSeq(ctx context.Context, locale *string, maxItems int) iter.Seq2[model.Category, error] {
+	return utils.Paginate(func(limit model.Limit, offset model.Offset) ([]model.Category, model.Pagination, error) {
+		page, err := s.GetCategories(ctx, locale, lo.ToPtr(limit.Int()), lo.ToPtr(offset.Int()))
+		return page.Items, page.Pagination, err
+	}, maxItems)
+}
+
+func (s *SpotifyBrowseService) GetAllCategories(ctx context.Context, locale *string, maxItems int) ([]model.Category, error) {
+	return utils.CollectAll(s.CategoriesSeq(ctx, locale, maxItems))
+}
+
+func toLocale(locale *string) *model.Locale {
+	if locale == nil {
+		return nil
+	}
+	return lo.ToPtr(model.Locale(*locale))
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+)
+
+type SpotifyChaptersService struct {
+	authService      AuthService
+	marketResolver   *utils.MarketResolver
+	chaptersResource resource.ChaptersResource
+}
+
+func NewSpotifyChaptersService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+	marketResolver *utils.MarketResolver,
+) ChaptersService {
+	return &SpotifyChaptersService{
+		authService:      authService,
+		marketResolver:   marketResolver,
+		chaptersResource: resource.NewSpotifyChaptersResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyChaptersService) GetChapter(
+	ctx context.Context,
+	countryMarketName *string,
+	chapterID string,
+) (model.Chapter, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.Chapter{}, fmt.Errorf("error getting chapter for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_chapterID, err := utils.ParseID(chapterID, model.EntityTypeChapter)
+	if err != nil {
+		return model.Chapter{}, fmt.Errorf("error getting chapter - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Chapter, error) {
+		return s.chaptersResource.GetChapter(ctx, accessToken, market, _chapterID)
+	})
+}
+
+func (s *SpotifyChaptersService) GetChapters(
+	ctx context.Context,
+	countryMarketName *string,
+	chaptersIDs ...string,
+) ([]model.Chapter, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return []model.Chapter{}, fmt.Errorf("error getting chapters for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_chaptersIDs, err := toIDs(chaptersIDs, model.EntityTypeChapter)
+	if err != nil {
+		return []model.Chapter{}, fmt.Errorf("error getting chapters - %w", err)
+	}
+	return utils.FetchInBatches(ctx, "chapter", _chaptersIDs, resource.MaxChaptersIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.Chapter, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Chapter, error) {
+				return s.chaptersResource.GetChapters(ctx, accessToken, market, batchIDs)
+			})
+		},
+		func(chapter model.Chapter) bool {
+			return chapter.ID == ""
+		},
+	)
+}
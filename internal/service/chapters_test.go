@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyChaptersService_GetChapters(t *testing.T) {
+	ids := newTestIDs("chapter", 53)
+
+	chaptersResource := resourcemocks.NewChaptersResource(t)
+	chaptersResource.On("GetChapters", mock.Anything, model.AccessToken("token-1"), (*model.AvailableMarket)(nil),
+		mock.MatchedBy(func(batchIDs model.ChaptersIDs) bool {
+			return len(batchIDs) <= resource.MaxChaptersIDs
+		})).
+		Return(func(_ context.Context, _ model.AccessToken, _ *model.AvailableMarket, batchIDs model.ChaptersIDs) ([]model.Chapter, error) {
+			// the last batch is answered short of its last chapter
+			if len(batchIDs) < resource.MaxChaptersIDs {
+				batchIDs = batchIDs[:len(batchIDs)-1]
+			}
+			chapters := make([]model.Chapter, len(batchIDs))
+			for i, id := range batchIDs {
+				chapters[i].ID = id
+			}
+			return chapters, nil
+		}).Times(2)
+
+	s := &SpotifyChaptersService{authService: newTestAuthService(t), chaptersResource: chaptersResource}
+	got, err := s.GetChapters(context.Background(), nil, ids...)
+
+	var missingErr commons.MissingIDsError
+	if !errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.IDs, []string{ids[52]}) {
+		t.Fatalf("GetChapters() error = %v, want %s reported as missing", err, ids[52])
+	}
+	if len(got) != len(ids) || got[51].ID != model.ID(ids[51]) || got[52].ID != "" {
+		t.Errorf("GetChapters() = %d chapters, want %d aligned with the ids", len(got), len(ids))
+	}
+}
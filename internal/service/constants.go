@@ -0,0 +1,10 @@
+package service
+
+import "time"
+
+const (
+	DefaultTokenRefreshSkew = time.Minute
+	// DefaultBatchConcurrency is how many batches of IDs are fetched at the same time when more IDs are requested
+	// than an endpoint accepts per call
+	DefaultBatchConcurrency = 4
+)
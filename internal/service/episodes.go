@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+)
+
+type SpotifyEpisodesService struct {
+	authService      AuthService
+	marketResolver   *utils.MarketResolver
+	episodesResource resource.EpisodesResource
+}
+
+func NewSpotifyEpisodesService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+	marketResolver *utils.MarketResolver,
+) EpisodesService {
+	return &SpotifyEpisodesService{
+		authService:      authService,
+		marketResolver:   marketResolver,
+		episodesResource: resource.NewSpotifyEpisodesResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyEpisodesService) GetEpisode(
+	ctx context.Context,
+	countryMarketName *string,
+	episodeID string,
+) (model.Episode, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.Episode{}, fmt.Errorf("error getting episode for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_episodeID, err := utils.ParseID(episodeID, model.EntityTypeEpisode)
+	if err != nil {
+		return model.Episode{}, fmt.Errorf("error getting episode - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Episode, error) {
+		return s.episodesResource.GetEpisode(ctx, accessToken, market, _episodeID)
+	})
+}
+
+func (s *SpotifyEpisodesService) GetEpisodes(
+	ctx context.Context,
+	countryMarketName *string,
+	episodesIDs ...string,
+) ([]model.Episode, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return []model.Episode{}, fmt.Errorf("error getting episodes for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_episodesIDs, err := toIDs(episodesIDs, model.EntityTypeEpisode)
+	if err != nil {
+		return []model.Episode{}, fmt.Errorf("error getting episodes - %w", err)
+	}
+	return utils.FetchInBatches(ctx, "episode", _episodesIDs, resource.MaxEpisodesIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.Episode, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Episode, error) {
+				return s.episodesResource.GetEpisodes(ctx, accessToken, market, batchIDs)
+			})
+		},
+		func(episode model.Episode) bool {
+			return episode.ID == ""
+		},
+	)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyEpisodesService_GetEpisodes(t *testing.T) {
+	ids := newTestIDs("episode", 75)
+	tests := []struct {
+		name      string
+		failingID string
+		wantErr   error
+	}{
+		{name: "should fetch every episode in batches"},
+		{name: "should return error when any batch fails", failingID: ids[60], wantErr: commons.ErrServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			episodesResource := resourcemocks.NewEpisodesResource(t)
+			call := episodesResource.On("GetEpisodes", mock.Anything, model.AccessToken("token-1"), (*model.AvailableMarket)(nil),
+				mock.MatchedBy(func(batchIDs model.EpisodesIDs) bool {
+					return len(batchIDs) <= resource.MaxEpisodesIDs
+				})).
+				Return(func(_ context.Context, _ model.AccessToken, _ *model.AvailableMarket, batchIDs model.EpisodesIDs) ([]model.Episode, error) {
+					episodes := make([]model.Episode, len(batchIDs))
+					for i, id := range batchIDs {
+						if id.String() == tt.failingID {
+							return nil, &commons.ResourceError{Status: 503, Message: "Service unavailable"}
+						}
+						episodes[i].ID = id
+					}
+					return episodes, nil
+				})
+			if tt.wantErr == nil {
+				call.Times(2)
+			} else {
+				// the failed batch cancels the one still pending, which may not have run
+				call.Maybe()
+			}
+
+			s := &SpotifyEpisodesService{authService: newTestAuthService(t), episodesResource: episodesResource}
+			got, err := s.GetEpisodes(context.Background(), nil, ids...)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetEpisodes() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got) != len(ids) {
+				t.Fatalf("GetEpisodes() returned %d episodes, want %d", len(got), len(ids))
+			}
+			for i, id := range ids {
+				if got[i].ID != model.ID(id) {
+					t.Errorf("GetEpisodes()[%d].ID = %q, want %q", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"slices"
+	"sync"
+)
+
+// SpotifyMarketsService keeps the markets answered by the API for the lifetime of the service, since they rarely
+// change and are consulted before every market-aware request.
+type SpotifyMarketsService struct {
+	authService     AuthService
+	marketsResource resource.MarketsResource
+	mu              sync.Mutex
+	markets         []model.AvailableMarket
+}
+
+func NewSpotifyMarketsService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+) MarketsService {
+	return &SpotifyMarketsService{
+		authService:     authService,
+		marketsResource: resource.NewSpotifyMarketsResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyMarketsService) GetAvailableMarkets(ctx context.Context) ([]model.AvailableMarket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.markets != nil {
+		return slices.Clone(s.markets), nil
+	}
+
+	markets, err := Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.AvailableMarket, error) {
+		return s.marketsResource.GetAvailableMarkets(ctx, accessToken)
+	})
+	if err != nil {
+		return []model.AvailableMarket{}, err
+	}
+	s.markets = markets
+	return slices.Clone(markets), nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	authmocks "jezz-go-spotify-integration/internal/mocks/auth"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyMarketsService_GetAvailableMarkets(t *testing.T) {
+	authFlow := authmocks.NewAuthenticationFlow(t)
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+	authService, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+	marketsResource := resourcemocks.NewMarketsResource(t)
+	marketsResource.On("GetAvailableMarkets", mock.Anything, model.AccessToken("token-1")).
+		Return([]model.AvailableMarket{"BR", "MX"}, nil).Once()
+	s := &SpotifyMarketsService{authService: authService, marketsResource: marketsResource}
+
+	want := []model.AvailableMarket{"BR", "MX"}
+	for range 2 {
+		got, err := s.GetAvailableMarkets(context.Background())
+		if err != nil {
+			t.Fatalf("GetAvailableMarkets() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetAvailableMarkets() = %v, want %v", got, want)
+		}
+	}
+}
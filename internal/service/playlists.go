@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+
+	"github.com/samber/lo"
+)
+
+type SpotifyPlaylistsService struct {
+	authService       AuthService
+	marketResolver    *utils.MarketResolver
+	playlistsResource resource.PlaylistsResource
+}
+
+func NewSpotifyPlaylistsService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+	marketResolver *utils.MarketResolver,
+) PlaylistsService {
+	return &SpotifyPlaylistsService{
+		authService:       authService,
+		marketResolver:    marketResolver,
+		playlistsResource: resource.NewSpotifyPlaylistsResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyPlaylistsService) GetPlaylist(
+	ctx context.Context,
+	countryMarketName *string,
+	fields *string,
+	additionalTypes *[]string,
+	playlistID string,
+) (model.Playlist, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.Playlist{}, fmt.Errorf("error getting playlist for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return model.Playlist{}, fmt.Errorf("error getting playlist - %w", err)
+	}
+
+	_fields, _additionalTypes := toPlaylistFilters(fields, additionalTypes)
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Playlist, error) {
+		return s.playlistsResource.GetPlaylist(ctx, accessToken, market, _fields, _additionalTypes, _playlistID)
+	})
+}
+
+func (s *SpotifyPlaylistsService) GetPlaylistItems(
+	ctx context.Context,
+	countryMarketName *string,
+	fields *string,
+	additionalTypes *[]string,
+	limit *int,
+	offset *int,
+	playlistID string,
+) (model.PlaylistItemsPaginated, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.PlaylistItemsPaginated{}, fmt.Errorf("error getting playlist items for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	var _limit *model.Limit
+	if limit != nil {
+		_limit = lo.ToPtr(model.Limit(*limit))
+	}
+	var _offset *model.Offset
+	if offset != nil {
+		_offset = lo.ToPtr(model.Offset(*offset))
+	}
+
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return model.PlaylistItemsPaginated{}, fmt.Errorf("error getting playlist items - %w", err)
+	}
+
+	_fields, _additionalTypes := toPlaylistFilters(fields, additionalTypes)
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.PlaylistItemsPaginated, error) {
+		return s.playlistsResource.GetPlaylistItems(ctx, accessToken, market, _fields, _additionalTypes, _limit, _offset, _playlistID)
+	})
+}
+
+// PlaylistItemsSeq iterates over every item of the playlist, fetching its pages on demand. maxItems caps the
+// number of items when greater than 0.
+func (s *SpotifyPlaylistsService) PlaylistItemsSeq(
+	ctx context.Context,
+	countryMarketName *string,
+	additionalTypes *[]string,
+	maxItems int,
+	playlistID string,
+) iter.Seq2[model.PlaylistItem, error] {
+	return utils.Paginate(func(limit model.Limit, offset model.Offset) ([]model.PlaylistItem, model.Pagination, error) {
+		page, err := s.GetPlaylistItems(ctx, countryMarketName, nil, additionalTypes, lo.ToPtr(limit.Int()), lo.ToPtr(offset.Int()), playlistID)
+		return page.Items, page.Pagination, err
+	}, maxItems)
+}
+
+func (s *SpotifyPlaylistsService) GetAllPlaylistItems(
+	ctx context.Context,
+	countryMarketName *string,
+	additionalTypes *[]string,
+	maxItems int,
+	playlistID string,
+) ([]model.PlaylistItem, error) {
+	return utils.CollectAll(s.PlaylistItemsSeq(ctx, countryMarketName, additionalTypes, maxItems, playlistID))
+}
+
+func (s *SpotifyPlaylistsService) GetPlaylistCoverImage(ctx context.Context, playlistID string) ([]model.Image, error) {
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return []model.Image{}, fmt.Errorf("error getting playlist cover image - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Image, error) {
+		return s.playlistsResource.GetPlaylistCoverImage(ctx, accessToken, _playlistID)
+	})
+}
+
+func toPlaylistFilters(fields *string, additionalTypes *[]string) (*model.Fields, *model.AdditionalTypes) {
+	var _fields *model.Fields
+	if fields != nil && *fields != "" {
+		_fields = lo.ToPtr(model.Fields(*fields))
+	}
+	var _additionalTypes *model.AdditionalTypes
+	if additionalTypes != nil && len(*additionalTypes) > 0 {
+		_additionalTypes = lo.ToPtr(model.AdditionalTypes(lo.Map(*additionalTypes, func(additionalType string, _ int) model.AdditionalType {
+			return model.AdditionalType(additionalType)
+		})))
+	}
+	return _fields, _additionalTypes
+}
+
+func (s *SpotifyPlaylistsService) CreatePlaylist(
+	ctx context.Context,
+	userID string,
+	name string,
+	description *string,
+	public *bool,
+	collaborative *bool,
+) (model.Playlist, error) {
+	details := model.PlaylistDetails{Name: &name, Description: description, Public: public, Collaborative: collaborative}
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Playlist, error) {
+		return s.playlistsResource.CreatePlaylist(ctx, accessToken, model.ID(userID), details)
+	})
+}
+
+// ChangePlaylistDetails answers no snapshot, as Spotify doesn't return one when changing details. Reading it from the
+// playlist afterwards could answer the snapshot of another change made in between.
+func (s *SpotifyPlaylistsService) ChangePlaylistDetails(
+	ctx context.Context,
+	playlistID string,
+	name *string,
+	description *string,
+	public *bool,
+	collaborative *bool,
+) error {
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return fmt.Errorf("error changing playlist details - %w", err)
+	}
+
+	details := model.PlaylistDetails{Name: name, Description: description, Public: public, Collaborative: collaborative}
+	_, err = Execute(ctx, s.authService, func(accessToken model.AccessToken) (any, error) {
+		return nil, s.playlistsResource.ChangePlaylistDetails(ctx, accessToken, _playlistID, details)
+	})
+	return err
+}
+
+// AddPlaylistItems adds the items at position, or appends them when position is nil. More items than a request
+// accepts are added in consecutive requests, keeping their order.
+func (s *SpotifyPlaylistsService) AddPlaylistItems(
+	ctx context.Context,
+	playlistID string,
+	position *int,
+	uris ...string,
+) (model.SnapshotID, error) {
+	if len(uris) == 0 {
+		return "", fmt.Errorf("error adding items to playlist %s - at least one item uri must be provided", playlistID)
+	}
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return "", fmt.Errorf("error adding playlist items - %w", err)
+	}
+	_uris, err := toItemURIs(uris)
+	if err != nil {
+		return "", fmt.Errorf("error adding playlist items - %w", err)
+	}
+
+	var snapshotID model.SnapshotID
+	for i, batch := range lo.Chunk(_uris, resource.MaxPlaylistItemsURIs) {
+		request := model.AddPlaylistItemsRequest{URIs: batch}
+		if position != nil {
+			request.Position = lo.ToPtr(*position + i*resource.MaxPlaylistItemsURIs)
+		}
+		snapshotID, err = Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SnapshotID, error) {
+			return s.playlistsResource.AddPlaylistItems(ctx, accessToken, _playlistID, request)
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return snapshotID, nil
+}
+
+func (s *SpotifyPlaylistsService) ReorderPlaylistItems(
+	ctx context.Context,
+	playlistID string,
+	rangeStart int,
+	rangeLength int,
+	insertBefore int,
+	snapshotID *string,
+) (model.SnapshotID, error) {
+	request := model.ReorderPlaylistItemsRequest{
+		RangeStart:   rangeStart,
+		InsertBefore: insertBefore,
+		RangeLength:  &rangeLength,
+		SnapshotID:   toSnapshotID(snapshotID),
+	}
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return "", fmt.Errorf("error reordering playlist items - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SnapshotID, error) {
+		return s.playlistsResource.ReorderPlaylistItems(ctx, accessToken, _playlistID, request)
+	})
+}
+
+// ReplacePlaylistItems replaces every item of the playlist, clearing it when no uri is given. More items than a
+// request accepts are set by replacing with the first batch and appending the others.
+func (s *SpotifyPlaylistsService) ReplacePlaylistItems(
+	ctx context.Context,
+	playlistID string,
+	uris ...string,
+) (model.SnapshotID, error) {
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return "", fmt.Errorf("error replacing playlist items - %w", err)
+	}
+	_uris, err := toItemURIs(uris)
+	if err != nil {
+		return "", fmt.Errorf("error replacing playlist items - %w", err)
+	}
+
+	firstBatch := _uris[:min(len(_uris), resource.MaxPlaylistItemsURIs)]
+	snapshotID, err := Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SnapshotID, error) {
+		return s.playlistsResource.ReplacePlaylistItems(ctx, accessToken, _playlistID, model.ReplacePlaylistItemsRequest{URIs: firstBatch})
+	})
+	if err != nil || len(uris) <= resource.MaxPlaylistItemsURIs {
+		return snapshotID, err
+	}
+	return s.AddPlaylistItems(ctx, playlistID, nil, uris[resource.MaxPlaylistItemsURIs:]...)
+}
+
+// RemovePlaylistItems removes every occurrence of the items. When snapshotID is given the removal applies to that
+// version of the playlist. More items than a request accepts are removed in consecutive requests.
+func (s *SpotifyPlaylistsService) RemovePlaylistItems(
+	ctx context.Context,
+	playlistID string,
+	snapshotID *string,
+	uris ...string,
+) (model.SnapshotID, error) {
+	if len(uris) == 0 {
+		return "", fmt.Errorf("error removing items from playlist %s - at least one item uri must be provided", playlistID)
+	}
+	_playlistID, err := utils.ParseID(playlistID, model.EntityTypePlaylist)
+	if err != nil {
+		return "", fmt.Errorf("error removing playlist items - %w", err)
+	}
+	_uris, err := toItemURIs(uris)
+	if err != nil {
+		return "", fmt.Errorf("error removing playlist items - %w", err)
+	}
+
+	currentSnapshotID := toSnapshotID(snapshotID)
+	var newSnapshotID model.SnapshotID
+	for _, batch := range lo.Chunk(_uris, resource.MaxPlaylistItemsURIs) {
+		request := model.RemovePlaylistItemsRequest{
+			Tracks: lo.Map(batch, func(uri model.URI, _ int) model.PlaylistItemURI {
+				return model.PlaylistItemURI{URI: uri}
+			}),
+			SnapshotID: currentSnapshotID,
+		}
+		newSnapshotID, err = Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SnapshotID, error) {
+			return s.playlistsResource.RemovePlaylistItems(ctx, accessToken, _playlistID, request)
+		})
+		if err != nil {
+			return "", err
+		}
+		if currentSnapshotID != nil {
+			currentSnapshotID = lo.ToPtr(newSnapshotID)
+		}
+	}
+	return newSnapshotID, nil
+}
+
+func toSnapshotID(snapshotID *string) *model.SnapshotID {
+	if snapshotID == nil || *snapshotID == "" {
+		return nil
+	}
+	return lo.ToPtr(model.SnapshotID(*snapshotID))
+}
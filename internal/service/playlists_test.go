@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	authmocks "jezz-go-spotify-integration/internal/mocks/auth"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestPlaylistsService(t *testing.T) (*SpotifyPlaylistsService, *resourcemocks.PlaylistsResource) {
+	t.Helper()
+	authFlow := authmocks.NewAuthenticationFlow(t)
+	authFlow.On("Authenticate", mock.Anything).Return(newAuthentication("token-1", 3600), nil).Once()
+	authService, err := NewSpotifyAuthService(context.Background(), authFlow, DefaultTokenRefreshSkew)
+	if err != nil {
+		t.Fatalf("NewSpotifyAuthService() error = %v", err)
+	}
+	playlistsResource := resourcemocks.NewPlaylistsResource(t)
+	return &SpotifyPlaylistsService{authService: authService, playlistsResource: playlistsResource}, playlistsResource
+}
+
+const testPlaylistID = "3cEYpjA9oz9GiPac4AsH4n"
+
+func newTrackURIs(n int) []string {
+	uris := make([]string, n)
+	for i := range uris {
+		uris[i] = fmt.Sprintf("spotify:track:track%017d", i)
+	}
+	return uris
+}
+
+func TestSpotifyPlaylistsService_AddPlaylistItems(t *testing.T) {
+	s, playlistsResource := newTestPlaylistsService(t)
+	uris := newTrackURIs(250)
+	for i, wantPosition := range []int{5, 105, 205} {
+		first := model.URI(uris[i*100])
+		playlistsResource.On("AddPlaylistItems", mock.Anything, model.AccessToken("token-1"), model.ID(testPlaylistID),
+			mock.MatchedBy(func(request model.AddPlaylistItemsRequest) bool {
+				return request.URIs[0] == first && request.Position != nil && *request.Position == wantPosition
+			})).
+			Return(model.SnapshotID(fmt.Sprintf("snapshot-%d", i+1)), nil).Once()
+	}
+
+	got, err := s.AddPlaylistItems(context.Background(), testPlaylistID, lo.ToPtr(5), uris...)
+	if err != nil {
+		t.Fatalf("AddPlaylistItems() error = %v", err)
+	}
+	if got != "snapshot-3" {
+		t.Errorf("AddPlaylistItems() = %q, want the snapshot of the last request", got)
+	}
+}
+
+func TestSpotifyPlaylistsService_ReplacePlaylistItems(t *testing.T) {
+	s, playlistsResource := newTestPlaylistsService(t)
+	uris := newTrackURIs(130)
+	playlistsResource.On("ReplacePlaylistItems", mock.Anything, model.AccessToken("token-1"), model.ID(testPlaylistID),
+		mock.MatchedBy(func(request model.ReplacePlaylistItemsRequest) bool {
+			return len(request.URIs) == 100
+		})).
+		Return(model.SnapshotID("snapshot-1"), nil).Once()
+	playlistsResource.On("AddPlaylistItems", mock.Anything, model.AccessToken("token-1"), model.ID(testPlaylistID),
+		mock.MatchedBy(func(request model.AddPlaylistItemsRequest) bool {
+			return len(request.URIs) == 30 && request.URIs[0] == model.URI(uris[100]) && request.Position == nil
+		})).
+		Return(model.SnapshotID("snapshot-2"), nil).Once()
+
+	got, err := s.ReplacePlaylistItems(context.Background(), testPlaylistID, uris...)
+	if err != nil || got != "snapshot-2" {
+		t.Errorf("ReplacePlaylistItems() = %q, %v, want snapshot-2", got, err)
+	}
+}
+
+func TestSpotifyPlaylistsService_RemovePlaylistItems(t *testing.T) {
+	s, playlistsResource := newTestPlaylistsService(t)
+	uris := newTrackURIs(150)
+	playlistsResource.On("RemovePlaylistItems", mock.Anything, model.AccessToken("token-1"), model.ID(testPlaylistID),
+		mock.MatchedBy(func(request model.RemovePlaylistItemsRequest) bool {
+			return len(request.Tracks) == 100 && *request.SnapshotID == "snapshot-0"
+		})).
+		Return(model.SnapshotID("snapshot-1"), nil).Once()
+	playlistsResource.On("RemovePlaylistItems", mock.Anything, model.AccessToken("token-1"), model.ID(testPlaylistID),
+		mock.MatchedBy(func(request model.RemovePlaylistItemsRequest) bool {
+			return len(request.Tracks) == 50 && *request.SnapshotID == "snapshot-1"
+		})).
+		Return(model.SnapshotID("snapshot-2"), nil).Once()
+
+	got, err := s.RemovePlaylistItems(context.Background(), testPlaylistID, lo.ToPtr("snapshot-0"), uris...)
+	if err != nil || got != "snapshot-2" {
+		t.Errorf("RemovePlaylistItems() = %q, %v, want snapshot-2", got, err)
+	}
+}
+
+func TestSpotifyPlaylistsService_ChangePlaylistDetails(t *testing.T) {
+	s, playlistsResource := newTestPlaylistsService(t)
+	playlistsResource.On("ChangePlaylistDetails", mock.Anything, model.AccessToken("token-1"), model.ID(testPlaylistID),
+		model.PlaylistDetails{Name: lo.ToPtr("new name")}).
+		Return(nil).Once()
+
+	if err := s.ChangePlaylistDetails(context.Background(), testPlaylistID, lo.ToPtr("new name"), nil, nil, nil); err != nil {
+		t.Errorf("ChangePlaylistDetails() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/utils"
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+// toIDs parses IDs, Spotify URIs and share links of entityType, reporting every invalid one
+func toIDs(inputs []string, entityType model.EntityType) ([]model.ID, error) {
+	var errs []error
+	ids := lo.Map(inputs, func(input string, _ int) model.ID {
+		id, err := utils.ParseID(input, entityType)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return id
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return ids, nil
+}
+
+// itemTypes are the entity types playlist items may have
+var itemTypes = []model.EntityType{model.EntityTypeTrack, model.EntityTypeEpisode}
+
+// toItemURIs parses the URIs and share links of tracks and episodes into URIs. Plain IDs are taken as tracks.
+func toItemURIs(inputs []string) ([]model.URI, error) {
+	expected := fmt.Sprintf("%s or %s", model.EntityTypeTrack, model.EntityTypeEpisode)
+	var errs []error
+	uris := lo.Map(inputs, func(input string, _ int) model.URI {
+		id, err := utils.ParseID(input, model.EntityTypeTrack)
+		if err == nil {
+			return model.Reference{Type: model.EntityTypeTrack, ID: id}.URI()
+		}
+		reference, refErr := utils.ParseReference(input)
+		switch {
+		case refErr != nil:
+			// the error of ParseID also covers plain ids, so it is the one reported, for any item type
+			var invalidErr commons.InvalidReferenceError
+			if errors.As(err, &invalidErr) {
+				invalidErr.Expected = expected
+				err = invalidErr
+			}
+			errs = append(errs, err)
+			return ""
+		case !slices.Contains(itemTypes, reference.Type):
+			errs = append(errs, commons.InvalidReferenceError{
+				Input:    input,
+				Expected: expected,
+				Reason:   fmt.Sprintf("reference of type %s where %s is expected", reference.Type, expected),
+			})
+			return ""
+		}
+		return reference.URI()
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return uris, nil
+}
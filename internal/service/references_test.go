@@ -0,0 +1,104 @@
+package service
+
+import (
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"reflect"
+	"testing"
+)
+
+func TestToIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputs      []string
+		want        []model.ID
+		wantInvalid []string
+	}{
+		{
+			name:   "should accept ids, uris and share links",
+			inputs: []string{"11dFghVXANMlKmJXsNCbNl", "spotify:track:6kLCHFM39wkFjOuyPGLGeQ", "https://open.spotify.com/track/4rzfv0JLZfVhOhbSQ8o5jZ?si=1"},
+			want:   []model.ID{"11dFghVXANMlKmJXsNCbNl", "6kLCHFM39wkFjOuyPGLGeQ", "4rzfv0JLZfVhOhbSQ8o5jZ"},
+		},
+		{
+			name:        "should report every invalid input",
+			inputs:      []string{"spotify:album:4aawyAB9vmqN3uQ7FjRGTy", "11dFghVXANMlKmJXsNCbNl", "track-1"},
+			wantInvalid: []string{"spotify:album:4aawyAB9vmqN3uQ7FjRGTy", "track-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toIDs(tt.inputs, model.EntityTypeTrack)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toIDs() = %v, want %v", got, tt.want)
+			}
+			if got := invalidReferences(err); !reflect.DeepEqual(got, tt.wantInvalid) {
+				t.Errorf("toIDs() invalid inputs = %v, want %v", got, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func TestToItemURIs(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputs      []string
+		want        []model.URI
+		wantInvalid []string
+		wantReason  string
+	}{
+		{
+			name:   "should accept tracks and episodes, taking ids as tracks",
+			inputs: []string{"11dFghVXANMlKmJXsNCbNl", "https://open.spotify.com/episode/512ojhOuo1ktJprKbVcKyQ", "spotify:track:6kLCHFM39wkFjOuyPGLGeQ"},
+			want:   []model.URI{"spotify:track:11dFghVXANMlKmJXsNCbNl", "spotify:episode:512ojhOuo1ktJprKbVcKyQ", "spotify:track:6kLCHFM39wkFjOuyPGLGeQ"},
+		},
+		{
+			name:        "should reject other entity types",
+			inputs:      []string{"spotify:album:4aawyAB9vmqN3uQ7FjRGTy"},
+			wantInvalid: []string{"spotify:album:4aawyAB9vmqN3uQ7FjRGTy"},
+			wantReason:  "reference of type album where track or episode is expected",
+		},
+		{
+			name:        "should report why a share link of another entity type is rejected",
+			inputs:      []string{"https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M"},
+			wantInvalid: []string{"https://open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M"},
+			wantReason:  "reference of type playlist where track or episode is expected",
+		},
+		{
+			name:        "should report invalid ids",
+			inputs:      []string{"track-1"},
+			wantInvalid: []string{"track-1"},
+			wantReason:  "id must be 22 base62 characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toItemURIs(tt.inputs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toItemURIs() = %v, want %v", got, tt.want)
+			}
+			if got := invalidReferences(err); !reflect.DeepEqual(got, tt.wantInvalid) {
+				t.Errorf("toItemURIs() invalid inputs = %v, want %v", got, tt.wantInvalid)
+			}
+			var refErr commons.InvalidReferenceError
+			if tt.wantReason != "" && (!errors.As(err, &refErr) || refErr.Reason != tt.wantReason || refErr.Expected != "track or episode") {
+				t.Errorf("toItemURIs() error = %v, want reason %q for a track or episode", err, tt.wantReason)
+			}
+		})
+	}
+}
+
+func invalidReferences(err error) []string {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return nil
+	}
+	var inputs []string
+	for _, e := range joined.Unwrap() {
+		var refErr commons.InvalidReferenceError
+		if errors.As(e, &refErr) {
+			inputs = append(inputs, refErr.Input)
+		}
+	}
+	return inputs
+}
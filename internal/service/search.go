@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+
+	"github.com/samber/lo"
+)
+
+type SpotifySearchService struct {
+	authService    AuthService
+	marketResolver *utils.MarketResolver
+	searchResource resource.SearchResource
+}
+
+func NewSpotifySearchService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+	marketResolver *utils.MarketResolver,
+) SearchService {
+	return &SpotifySearchService{
+		authService:    authService,
+		marketResolver: marketResolver,
+		searchResource: resource.NewSpotifySearchResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifySearchService) Search(
+	ctx context.Context,
+	query model.SearchQuery,
+	searchTypes []string,
+	countryMarketName *string,
+	limit *int,
+	offset *int,
+	includeExternalAudio bool,
+) (model.SearchResult, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.SearchResult{}, fmt.Errorf("error searching for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_searchTypes := lo.Map(searchTypes, func(searchType string, _ int) model.SearchType {
+		return model.SearchType(searchType)
+	})
+	var _limit *model.Limit
+	if limit != nil {
+		_limit = lo.ToPtr(model.Limit(*limit))
+	}
+	var _offset *model.Offset
+	if offset != nil {
+		_offset = lo.ToPtr(model.Offset(*offset))
+	}
+	var includeExternal *model.IncludeExternal
+	if includeExternalAudio {
+		includeExternal = lo.ToPtr(model.IncludeExternalAudio)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SearchResult, error) {
+		return s.searchResource.Search(ctx, accessToken, query, _searchTypes, market, _limit, _offset, includeExternal)
+	})
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"jezz-go-spotify-integration/internal/utils"
+
+	"github.com/samber/lo"
+)
+
+type SpotifyShowsService struct {
+	authService    AuthService
+	marketResolver *utils.MarketResolver
+	showsResource  resource.ShowsResource
+}
+
+func NewSpotifyShowsService(
+	baseURL string,
+	httpAPIClient client.HTTPApiClient,
+	authService AuthService,
+	marketResolver *utils.MarketResolver,
+) ShowsService {
+	return &SpotifyShowsService{
+		authService:    authService,
+		marketResolver: marketResolver,
+		showsResource:  resource.NewSpotifyShowsResource(httpAPIClient, baseURL),
+	}
+}
+
+func (s *SpotifyShowsService) GetShow(
+	ctx context.Context,
+	countryMarketName *string,
+	showID string,
+) (model.Show, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.Show{}, fmt.Errorf("error getting show for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_showID, err := utils.ParseID(showID, model.EntityTypeShow)
+	if err != nil {
+		return model.Show{}, fmt.Errorf("error getting show - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Show, error) {
+		return s.showsResource.GetShow(ctx, accessToken, market, _showID)
+	})
+}
+
+func (s *SpotifyShowsService) GetShows(
+	ctx context.Context,
+	countryMarketName *string,
+	showsIDs ...string,
+) ([]model.SimplifiedShow, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return []model.SimplifiedShow{}, fmt.Errorf("error getting shows for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	_showsIDs, err := toIDs(showsIDs, model.EntityTypeShow)
+	if err != nil {
+		return []model.SimplifiedShow{}, fmt.Errorf("error getting shows - %w", err)
+	}
+	return utils.FetchInBatches(ctx, "show", _showsIDs, resource.MaxShowsIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.SimplifiedShow, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.SimplifiedShow, error) {
+				return s.showsResource.GetShows(ctx, accessToken, market, batchIDs)
+			})
+		},
+		func(show model.SimplifiedShow) bool {
+			return show.ID == ""
+		},
+	)
+}
+
+func (s *SpotifyShowsService) GetShowEpisodes(
+	ctx context.Context,
+	countryMarketName *string,
+	limit *int,
+	offset *int,
+	showID string,
+) (model.SimplifiedEpisodesPaginated, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
+	if err != nil {
+		return model.SimplifiedEpisodesPaginated{}, fmt.Errorf("error getting show episodes for market %s - invalid market: %w", *countryMarketName, err)
+	}
+
+	var _limit *model.Limit
+	if limit != nil {
+		_limit = lo.ToPtr(model.Limit(*limit))
+	}
+	var _offset *model.Offset
+	if offset != nil {
+		_offset = lo.ToPtr(model.Offset(*offset))
+	}
+
+	_showID, err := utils.ParseID(showID, model.EntityTypeShow)
+	if err != nil {
+		return model.SimplifiedEpisodesPaginated{}, fmt.Errorf("error getting show episodes - %w", err)
+	}
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.SimplifiedEpisodesPaginated, error) {
+		return s.showsResource.GetShowEpisodes(ctx, accessToken, market, _limit, _offset, _showID)
+	})
+}
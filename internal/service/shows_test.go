@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/client"
+	"jezz-go-spotify-integration/internal/commons"
+	clientmocks "jezz-go-spotify-integration/internal/mocks/client"
+	resourcemocks "jezz-go-spotify-integration/internal/mocks/resource"
+	"jezz-go-spotify-integration/internal/model"
+	"jezz-go-spotify-integration/internal/resource"
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestIDs returns n valid Spotify IDs starting with prefix
+func newTestIDs(prefix string, n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%s%0*d", prefix, 22-len(prefix), i)
+	}
+	return ids
+}
+
+func TestSpotifyShowsService_GetShows(t *testing.T) {
+	ids := newTestIDs("show", 120)
+
+	showsResource := resourcemocks.NewShowsResource(t)
+	showsResource.On("GetShows", mock.Anything, model.AccessToken("token-1"), (*model.AvailableMarket)(nil),
+		mock.MatchedBy(func(batchIDs model.ShowsIDs) bool {
+			return len(batchIDs) <= resource.MaxShowsIDs
+		})).
+		Return(func(_ context.Context, _ model.AccessToken, _ *model.AvailableMarket, batchIDs model.ShowsIDs) ([]model.SimplifiedShow, error) {
+			shows := make([]model.SimplifiedShow, len(batchIDs))
+			for i, id := range batchIDs {
+				if id != model.ID(ids[103]) {
+					shows[i].ID = id
+				}
+			}
+			return shows, nil
+		}).Times(3)
+
+	s := &SpotifyShowsService{authService: newTestAuthService(t), showsResource: showsResource}
+	got, err := s.GetShows(context.Background(), nil, ids...)
+
+	var missingErr commons.MissingIDsError
+	if !errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.IDs, []string{ids[103]}) {
+		t.Fatalf("GetShows() error = %v, want %s reported as missing", err, ids[103])
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("GetShows() returned %d shows, want %d", len(got), len(ids))
+	}
+	for i, id := range ids {
+		want := model.ID(id)
+		if i == 103 {
+			want = ""
+		}
+		if got[i].ID != want {
+			t.Errorf("GetShows()[%d].ID = %q, want %q", i, got[i].ID, want)
+		}
+	}
+}
+
+func TestSpotifyShowsService_GetShowEpisodes(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       *int
+		offset      *int
+		showID      string
+		wantRequest bool
+		wantErr     bool
+	}{
+		{name: "should request the page given by limit and offset", limit: lo.ToPtr(10), offset: lo.ToPtr(5), showID: "38bS44xjbVVZ3No3ByF1dJ", wantRequest: true},
+		{name: "should request the first page without limit and offset", showID: "https://open.spotify.com/show/38bS44xjbVVZ3No3ByF1dJ", wantRequest: true},
+		{name: "should return error when limit is above the maximum", limit: lo.ToPtr(51), showID: "38bS44xjbVVZ3No3ByF1dJ", wantErr: true},
+		{name: "should return error when offset is negative", offset: lo.ToPtr(-1), showID: "38bS44xjbVVZ3No3ByF1dJ", wantErr: true},
+		{name: "should return error when show id is invalid", showID: "spotify:album:38bS44xjbVVZ3No3ByF1dJ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := clientmocks.NewHTTPApiClient(t)
+			if tt.wantRequest {
+				wantParams := &model.QueryParams{"market": (*model.AvailableMarket)(nil), "limit": (*model.Limit)(nil), "offset": (*model.Offset)(nil)}
+				if tt.limit != nil {
+					(*wantParams)["limit"] = lo.ToPtr(model.Limit(*tt.limit))
+				}
+				if tt.offset != nil {
+					(*wantParams)["offset"] = lo.ToPtr(model.Offset(*tt.offset))
+				}
+				httpClient.On("DoRequest", mock.Anything, model.HTTPGet, "https://api.spotify.com/v1/shows/38bS44xjbVVZ3No3ByF1dJ/episodes",
+					wantParams, client.ContentTypeJSON, mock.Anything, nil, mock.AnythingOfType("*model.SimplifiedEpisodesPaginated")).
+					Return(nil).Once()
+			}
+
+			s := &SpotifyShowsService{authService: newTestAuthService(t), showsResource: resource.NewSpotifyShowsResource(httpClient, "https://api.spotify.com")}
+			_, err := s.GetShowEpisodes(context.Background(), nil, tt.limit, tt.offset, tt.showID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetShowEpisodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
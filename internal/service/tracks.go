@@ -1,17 +1,17 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"jezz-go-spotify-integration/internal/client"
 	"jezz-go-spotify-integration/internal/model"
 	"jezz-go-spotify-integration/internal/resource"
 	"jezz-go-spotify-integration/internal/utils"
-
-	"github.com/samber/lo"
 )
 
 type SpotifyTracksService struct {
 	authService    AuthService
+	marketResolver *utils.MarketResolver
 	tracksResource resource.TracksResource
 }
 
@@ -19,43 +19,50 @@ func NewSpotifyTracksService(
 	baseURL string,
 	httpAPIClient client.HTTPApiClient,
 	authService AuthService,
+	marketResolver *utils.MarketResolver,
 ) TracksService {
 	return &SpotifyTracksService{
 		authService:    authService,
+		marketResolver: marketResolver,
 		tracksResource: resource.NewSpotifyTracksResource(httpAPIClient, baseURL),
 	}
 }
 
-func (s *SpotifyTracksService) GetTrack(countryMarketName *string, trackID string) (model.Track, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+func (s *SpotifyTracksService) GetTrack(ctx context.Context, countryMarketName *string, trackID string) (model.Track, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return model.Track{}, fmt.Errorf("errror getting track for country %s - unknown country! Details: %w", *countryMarketName, err)
+		return model.Track{}, fmt.Errorf("error getting track for country %s - unknown country! Details: %w", *countryMarketName, err)
 	}
 
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.tracksResource.GetTrack(accessToken, market, model.ID(trackID))
-	})
-	if errA != nil {
-		return model.Track{}, errA
+	_trackID, err := utils.ParseID(trackID, model.EntityTypeTrack)
+	if err != nil {
+		return model.Track{}, fmt.Errorf("error getting track - %w", err)
 	}
-	return result.(model.Track), nil
+
+	return Execute(ctx, s.authService, func(accessToken model.AccessToken) (model.Track, error) {
+		return s.tracksResource.GetTrack(ctx, accessToken, market, _trackID)
+	})
 }
 
-func (s *SpotifyTracksService) GetTracks(countryMarketName *string, tracksIDs ...string) ([]model.Track, error) {
-	market, err := utils.GetMarketByCountryName(countryMarketName)
+func (s *SpotifyTracksService) GetTracks(ctx context.Context, countryMarketName *string, tracksIDs ...string) ([]model.Track, error) {
+	market, err := s.marketResolver.Resolve(ctx, countryMarketName)
 	if err != nil {
-		return []model.Track{}, fmt.Errorf("errror getting tracks for country %s - unknown country! Details: %w", *countryMarketName, err)
+		return []model.Track{}, fmt.Errorf("error getting tracks for country %s - unknown country! Details: %w", *countryMarketName, err)
 	}
 
-	_tracksIDs := lo.Map(tracksIDs, func(trackID string, _ int) model.ID {
-		return model.ID(trackID)
-	})
-
-	result, errA := s.authService.ExecuteWithAuthentication(func(accessToken model.AccessToken) (any, error) {
-		return s.tracksResource.GetTracks(accessToken, market, _tracksIDs)
-	})
-	if errA != nil {
-		return []model.Track{}, errA
+	_tracksIDs, err := toIDs(tracksIDs, model.EntityTypeTrack)
+	if err != nil {
+		return []model.Track{}, fmt.Errorf("error getting tracks - %w", err)
 	}
-	return result.([]model.Track), nil
+
+	return utils.FetchInBatches(ctx, "track", _tracksIDs, resource.MaxTracksIDs, DefaultBatchConcurrency,
+		func(ctx context.Context, batchIDs []model.ID) ([]model.Track, error) {
+			return Execute(ctx, s.authService, func(accessToken model.AccessToken) ([]model.Track, error) {
+				return s.tracksResource.GetTracks(ctx, accessToken, market, batchIDs)
+			})
+		},
+		func(track model.Track) bool {
+			return track.ID == ""
+		},
+	)
 }
@@ -1,28 +1,114 @@
 package service
 
-import "jezz-go-spotify-integration/internal/model"
+import (
+	"context"
+	"iter"
+	"jezz-go-spotify-integration/internal/model"
+)
 
 type ExecuteWithAuthenticationFn func(accessToken model.AccessToken) (any, error)
 
 type AuthService interface {
-	ExecuteWithAuthentication(fn ExecuteWithAuthenticationFn) (any, error)
+	ExecuteWithAuthentication(ctx context.Context, fn ExecuteWithAuthenticationFn) (any, error)
 }
 
+// The services take catalog entities as IDs, Spotify URIs or open.spotify.com share links, see utils.ParseID, and
+// reject references to another type of entity with a commons.InvalidReferenceError.
+
 type AlbumsService interface {
-	GetAlbum(countryMarketName *string, albumID string) (model.Album, error)
-	GetAlbums(countryMarketName *string, albumsIDs ...string) ([]model.Album, error)
-	GetAlbumTracks(countryMarketName *string, limit *int, offset *int, albumID string) (model.SimplifiedTracksPaginated, error)
-	GetNewReleases(limit *int, offset *int) (model.AlbumsNewRelease, error)
+	GetAlbum(ctx context.Context, countryMarketName *string, albumID string) (model.Album, error)
+	// GetAlbums accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetAlbums(ctx context.Context, countryMarketName *string, albumsIDs ...string) ([]model.Album, error)
+	GetAlbumTracks(ctx context.Context, countryMarketName *string, limit *int, offset *int, albumID string) (model.SimplifiedTracksPaginated, error)
+	GetNewReleases(ctx context.Context, limit *int, offset *int) (model.AlbumsNewRelease, error)
+	AlbumTracksSeq(ctx context.Context, countryMarketName *string, maxItems int, albumID string) iter.Seq2[model.SimplifiedTrack, error]
+	GetAllAlbumTracks(ctx context.Context, countryMarketName *string, maxItems int, albumID string) ([]model.SimplifiedTrack, error)
+	NewReleasesSeq(ctx context.Context, maxItems int) iter.Seq2[model.SimplifiedAlbum, error]
+	GetAllNewReleases(ctx context.Context, maxItems int) ([]model.SimplifiedAlbum, error)
 }
 
 type ArtistsService interface {
-	GetArtist(artistID string) (model.Artist, error)
-	GetArtists(artistIDsStr ...string) ([]model.Artist, error)
-	GetArtistAlbums(countryMarketName *string, albumTypes *[]string, limit *int, offset *int, albumID string) (model.SimplifiedArtistAlbumsPaginated, error)
-	GetArtistTopTracks(countryMarketName *string, artistID string) ([]model.Track, error)
+	GetArtist(ctx context.Context, artistID string) (model.Artist, error)
+	// GetArtists accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetArtists(ctx context.Context, artistIDsStr ...string) ([]model.Artist, error)
+	GetArtistAlbums(ctx context.Context, countryMarketName *string, albumTypes *[]string, limit *int, offset *int, albumID string) (model.SimplifiedArtistAlbumsPaginated, error)
+	GetArtistTopTracks(ctx context.Context, countryMarketName *string, artistID string) ([]model.Track, error)
+	ArtistAlbumsSeq(ctx context.Context, countryMarketName *string, albumTypes *[]string, maxItems int, artistID string) iter.Seq2[model.SimplifiedArtistAlbum, error]
+	GetAllArtistAlbums(ctx context.Context, countryMarketName *string, albumTypes *[]string, maxItems int, artistID string) ([]model.SimplifiedArtistAlbum, error)
+}
+
+type PlaylistsService interface {
+	// GetPlaylist and GetPlaylistItems accept a fields filter and additionalTypes (track and/or episode) to
+	// receive episodes as episodes instead of tracks.
+	GetPlaylist(ctx context.Context, countryMarketName *string, fields *string, additionalTypes *[]string, playlistID string) (model.Playlist, error)
+	GetPlaylistItems(ctx context.Context, countryMarketName *string, fields *string, additionalTypes *[]string, limit *int, offset *int, playlistID string) (model.PlaylistItemsPaginated, error)
+	PlaylistItemsSeq(ctx context.Context, countryMarketName *string, additionalTypes *[]string, maxItems int, playlistID string) iter.Seq2[model.PlaylistItem, error]
+	GetAllPlaylistItems(ctx context.Context, countryMarketName *string, additionalTypes *[]string, maxItems int, playlistID string) ([]model.PlaylistItem, error)
+	GetPlaylistCoverImage(ctx context.Context, playlistID string) ([]model.Image, error)
+	// The playlist mutations answer the snapshot_id of the playlist version they produced, except for the details
+	// change, which Spotify answers without it
+	CreatePlaylist(ctx context.Context, userID string, name string, description *string, public *bool, collaborative *bool) (model.Playlist, error)
+	ChangePlaylistDetails(ctx context.Context, playlistID string, name *string, description *string, public *bool, collaborative *bool) error
+	AddPlaylistItems(ctx context.Context, playlistID string, position *int, uris ...string) (model.SnapshotID, error)
+	ReorderPlaylistItems(ctx context.Context, playlistID string, rangeStart int, rangeLength int, insertBefore int, snapshotID *string) (model.SnapshotID, error)
+	ReplacePlaylistItems(ctx context.Context, playlistID string, uris ...string) (model.SnapshotID, error)
+	RemovePlaylistItems(ctx context.Context, playlistID string, snapshotID *string, uris ...string) (model.SnapshotID, error)
+}
+
+type SearchService interface {
+	// Search looks the query up in the catalog, searchTypes being any of album, artist, playlist, track, show and episode.
+	// includeExternalAudio marks externally hosted audio content as playable in the response.
+	Search(ctx context.Context, query model.SearchQuery, searchTypes []string, countryMarketName *string, limit *int, offset *int, includeExternalAudio bool) (model.SearchResult, error)
 }
 
 type TracksService interface {
-	GetTrack(countryMarketName *string, trackID string) (model.Track, error)
-	GetTracks(countryMarketName *string, tracksIDs ...string) ([]model.Track, error)
+	GetTrack(ctx context.Context, countryMarketName *string, trackID string) (model.Track, error)
+	// GetTracks accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetTracks(ctx context.Context, countryMarketName *string, tracksIDs ...string) ([]model.Track, error)
+}
+
+type ShowsService interface {
+	GetShow(ctx context.Context, countryMarketName *string, showID string) (model.Show, error)
+	// GetShows accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetShows(ctx context.Context, countryMarketName *string, showsIDs ...string) ([]model.SimplifiedShow, error)
+	GetShowEpisodes(ctx context.Context, countryMarketName *string, limit *int, offset *int, showID string) (model.SimplifiedEpisodesPaginated, error)
+}
+
+type EpisodesService interface {
+	GetEpisode(ctx context.Context, countryMarketName *string, episodeID string) (model.Episode, error)
+	// GetEpisodes accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetEpisodes(ctx context.Context, countryMarketName *string, episodesIDs ...string) ([]model.Episode, error)
+}
+
+type AudiobooksService interface {
+	GetAudiobook(ctx context.Context, countryMarketName *string, audiobookID string) (model.Audiobook, error)
+	// GetAudiobooks accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetAudiobooks(ctx context.Context, countryMarketName *string, audiobooksIDs ...string) ([]model.Audiobook, error)
+	GetAudiobookChapters(ctx context.Context, countryMarketName *string, limit *int, offset *int, audiobookID string) (model.SimplifiedChaptersPaginated, error)
+}
+
+type ChaptersService interface {
+	GetChapter(ctx context.Context, countryMarketName *string, chapterID string) (model.Chapter, error)
+	// GetChapters accepts any number of IDs and fetches them in batches the API accepts. The result is aligned with the
+	// IDs; unknown IDs are left as zero values and reported in a commons.MissingIDsError along with the items found.
+	GetChapters(ctx context.Context, countryMarketName *string, chaptersIDs ...string) ([]model.Chapter, error)
+}
+
+type BrowseService interface {
+	// locale narrows the category names to a language and country, e.g. "es_MX"
+	GetCategories(ctx context.Context, locale *string, limit *int, offset *int) (model.CategoriesPaginated, error)
+	GetCategory(ctx context.Context, locale *string, categoryID string) (model.Category, error)
+	CategoriesSeq(ctx context.Context, locale *string, maxItems int) iter.Seq2[model.Category, error]
+	GetAllCategories(ctx context.Context, locale *string, maxItems int) ([]model.Category, error)
+}
+
+type MarketsService interface {
+	// GetAvailableMarkets answers the markets where Spotify is available, fetching them only once
+	GetAvailableMarkets(ctx context.Context) ([]model.AvailableMarket, error)
 }
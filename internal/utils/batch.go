@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"sync"
+)
+
+// FetchBatchFn fetches the items of a batch of IDs, answering them in the same order as the IDs.
+type FetchBatchFn[T any] func(ctx context.Context, ids []model.ID) ([]T, error)
+
+// FetchInBatches splits ids into batches of at most batchSize IDs and fetches them running no more than
+// concurrency batches at the same time. The items are returned aligned with ids. The first failed batch
+// cancels the ones still pending and its error is returned.
+//
+// Items isMissing reports as missing (Spotify answers unknown IDs with null) are left as zero values and their
+// IDs are returned in a commons.MissingIDsError, along with the items that were found.
+func FetchInBatches[T any](
+	ctx context.Context,
+	resourceName string,
+	ids []model.ID,
+	batchSize int,
+	concurrency int,
+	fetchBatch FetchBatchFn[T],
+	isMissing func(item T) bool,
+) ([]T, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("at least one id must be provided")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	items := make([]T, len(ids))
+	found := make([]bool, len(ids))
+	semaphore := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var batchErr error
+
+	for start := 0; start < len(ids); start += batchSize {
+		end := min(start+batchSize, len(ids))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() {
+				<-semaphore
+			}()
+			if ctx.Err() != nil {
+				return
+			}
+
+			batch, err := fetchBatch(ctx, ids[start:end])
+			if err != nil {
+				errOnce.Do(func() {
+					batchErr = err
+					cancel()
+				})
+				return
+			}
+			// a shorter answer than requested leaves the remaining ids of the batch as missing
+			for i := 0; i < len(batch) && start+i < end; i++ {
+				items[start+i] = batch[i]
+				found[start+i] = !isMissing(batch[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if batchErr != nil {
+		return nil, batchErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for i, id := range ids {
+		if !found[i] {
+			missing = append(missing, id.String())
+		}
+	}
+	if len(missing) > 0 {
+		return items, commons.MissingIDsError{Resource: resourceName, IDs: missing}
+	}
+	return items, nil
+}
@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newIDs(n int) []model.ID {
+	ids := make([]model.ID, n)
+	for i := range ids {
+		ids[i] = model.ID(fmt.Sprintf("id-%d", i))
+	}
+	return ids
+}
+
+func TestFetchInBatches(t *testing.T) {
+	tests := []struct {
+		name          string
+		ids           []model.ID
+		batchSize     int
+		concurrency   int
+		unknownIDs    map[model.ID]bool
+		truncateBatch bool
+		failBatch     int
+		wantBatches   int
+		wantMissing   []string
+		wantErr       bool
+	}{
+		{
+			name:        "should fetch a single batch when ids fit in it",
+			ids:         newIDs(5),
+			batchSize:   20,
+			concurrency: 4,
+			failBatch:   -1,
+			wantBatches: 1,
+		},
+		{
+			name:        "should split ids in batches and keep the input order",
+			ids:         newIDs(105),
+			batchSize:   20,
+			concurrency: 3,
+			failBatch:   -1,
+			wantBatches: 6,
+		},
+		{
+			name:        "should report unknown ids",
+			ids:         newIDs(45),
+			batchSize:   20,
+			concurrency: 2,
+			unknownIDs:  map[model.ID]bool{"id-3": true, "id-41": true},
+			failBatch:   -1,
+			wantBatches: 3,
+			wantMissing: []string{"id-3", "id-41"},
+			wantErr:     true,
+		},
+		{
+			name:          "should report ids left out of a short answer",
+			ids:           newIDs(3),
+			batchSize:     20,
+			concurrency:   1,
+			truncateBatch: true,
+			failBatch:     -1,
+			wantBatches:   1,
+			wantMissing:   []string{"id-2"},
+			wantErr:       true,
+		},
+		{
+			name:        "should return the error of a failed batch",
+			ids:         newIDs(60),
+			batchSize:   20,
+			concurrency: 1,
+			failBatch:   1,
+			wantErr:     true,
+		},
+		{
+			name:        "should return error when no id is given",
+			batchSize:   20,
+			concurrency: 1,
+			failBatch:   -1,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			positions := map[model.ID]int{}
+			for i, id := range tt.ids {
+				positions[id] = i
+			}
+			var batches, inFlight, maxInFlight atomic.Int32
+			got, err := FetchInBatches(context.Background(), "item", tt.ids, tt.batchSize, tt.concurrency,
+				func(ctx context.Context, ids []model.ID) ([]string, error) {
+					batches.Add(1)
+					current := inFlight.Add(1)
+					defer inFlight.Add(-1)
+					for {
+						observed := maxInFlight.Load()
+						if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+							break
+						}
+					}
+					if len(ids) > tt.batchSize {
+						t.Errorf("fetchBatch() called with %d ids, more than %d", len(ids), tt.batchSize)
+					}
+					// later batches answer first, so results arrive out of order
+					time.Sleep(time.Duration(len(tt.ids)-positions[ids[0]]) * 50 * time.Microsecond)
+					if tt.failBatch >= 0 && ids[0] == tt.ids[tt.failBatch*tt.batchSize] {
+						return nil, errors.New("mock batch error")
+					}
+					items := make([]string, 0, len(ids))
+					for _, id := range ids {
+						if tt.unknownIDs[id] {
+							items = append(items, "")
+						} else {
+							items = append(items, "item-"+id.String())
+						}
+					}
+					if tt.truncateBatch {
+						items = items[:len(items)-1]
+					}
+					return items, nil
+				},
+				func(item string) bool {
+					return item == ""
+				},
+			)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FetchInBatches() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if maxInFlight.Load() > int32(tt.concurrency) {
+				t.Errorf("FetchInBatches() ran %d batches at once, more than %d", maxInFlight.Load(), tt.concurrency)
+			}
+			if tt.wantBatches > 0 && batches.Load() != int32(tt.wantBatches) {
+				t.Errorf("FetchInBatches() fetched %d batches, want %d", batches.Load(), tt.wantBatches)
+			}
+
+			var missingErr commons.MissingIDsError
+			if tt.wantMissing != nil {
+				if !errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.IDs, tt.wantMissing) {
+					t.Errorf("FetchInBatches() error = %v, want missing ids %v", err, tt.wantMissing)
+				}
+			}
+			if err != nil && tt.wantMissing == nil {
+				if got != nil {
+					t.Errorf("FetchInBatches() = %v, want nil on error", got)
+				}
+				return
+			}
+			if len(got) != len(tt.ids) {
+				t.Fatalf("FetchInBatches() returned %d items, want %d", len(got), len(tt.ids))
+			}
+			missing := map[string]bool{}
+			for _, id := range tt.wantMissing {
+				missing[id] = true
+			}
+			for i, id := range tt.ids {
+				want := "item-" + id.String()
+				if missing[id.String()] {
+					want = ""
+				}
+				if got[i] != want {
+					t.Errorf("FetchInBatches()[%d] = %q, want %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchInBatches_CancelsPendingBatchesOnError(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []model.ID
+	_, err := FetchInBatches(context.Background(), "item", newIDs(100), 10, 1,
+		func(ctx context.Context, ids []model.ID) ([]string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fetched = append(fetched, ids[0])
+			return nil, errors.New("mock batch error")
+		},
+		func(item string) bool {
+			return item == ""
+		},
+	)
+	if err == nil {
+		t.Fatal("FetchInBatches() error = nil, want error")
+	}
+	if len(fetched) != 1 {
+		t.Errorf("FetchInBatches() fetched %d batches, want only the one that failed", len(fetched))
+	}
+}
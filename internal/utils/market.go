@@ -1,21 +1,185 @@
 package utils
 
 import (
+	"cmp"
+	"context"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
 	"jezz-go-spotify-integration/internal/model"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/pariz/gountries"
 	"github.com/samber/lo"
 )
 
-func GetMarketByCountryName(countryName *string) (*model.AvailableMarket, error) {
-	var market *model.AvailableMarket
-	if countryName != nil {
-		country, err := gountries.New().FindCountryByName(*countryName)
-		if err != nil {
-			return nil, err
+const (
+	// FromTokenMarket asks the API to use the country of the user the access token belongs to
+	FromTokenMarket model.AvailableMarket = "from_token"
+
+	maxMarketSuggestions = 5
+)
+
+// countries are sorted by name once, so close matches come out in a stable order
+var countries = sync.OnceValue(func() []gountries.Country {
+	all := lo.Values(gountries.New().FindAllCountries())
+	slices.SortFunc(all, func(a, b gountries.Country) int {
+		return cmp.Compare(a.Name.Common, b.Name.Common)
+	})
+	return all
+})
+
+// AvailableMarketsFn answers the markets Spotify serves, e.g. with a MarketsService.
+type AvailableMarketsFn func(ctx context.Context) ([]model.AvailableMarket, error)
+
+// MarketResolver turns user input into the market the API expects. It accepts English country names, ISO 3166-1
+// alpha-2 and alpha-3 codes and from_token, case-insensitively.
+type MarketResolver struct {
+	availableMarkets AvailableMarketsFn
+}
+
+// NewMarketResolver restricts the resolved markets to availableMarkets, as answered by the markets endpoint, so
+// countries Spotify doesn't serve are rejected before any request is sent. An empty list lifts the restriction.
+func NewMarketResolver(availableMarkets []model.AvailableMarket) *MarketResolver {
+	return NewLazyMarketResolver(func(context.Context) ([]model.AvailableMarket, error) {
+		return availableMarkets, nil
+	})
+}
+
+// NewLazyMarketResolver is a NewMarketResolver that gets the available markets only when a market is resolved, so
+// commands without a market don't wait for them. When they can't be got, markets aren't checked against them.
+func NewLazyMarketResolver(availableMarkets AvailableMarketsFn) *MarketResolver {
+	return &MarketResolver{availableMarkets: availableMarkets}
+}
+
+// Resolve answers nil when no market is given and a commons.InvalidMarketError when the market is unknown or not
+// served by Spotify.
+func (r *MarketResolver) Resolve(ctx context.Context, market *string) (*model.AvailableMarket, error) {
+	if market == nil {
+		return nil, nil
+	}
+	input := strings.TrimSpace(*market)
+	if strings.EqualFold(input, FromTokenMarket.String()) {
+		return lo.ToPtr(FromTokenMarket), nil
+	}
+
+	available := r.available(ctx)
+	country, ok := findCountry(input)
+	if !ok {
+		return nil, commons.InvalidMarketError{
+			Input:       *market,
+			Reason:      "unknown country name or code",
+			Suggestions: closeMatches(input, available),
+		}
+	}
+	resolved := model.AvailableMarket(country.Alpha2)
+	if !isAvailable(available, resolved) {
+		return nil, commons.InvalidMarketError{
+			Input:  *market,
+			Reason: fmt.Sprintf("spotify is not available in %s", formatCountry(country)),
+		}
+	}
+	return &resolved, nil
+}
+
+// available answers the markets Spotify serves, or nil when they are unknown and any market is accepted
+func (r *MarketResolver) available(ctx context.Context) map[model.AvailableMarket]struct{} {
+	if r == nil || r.availableMarkets == nil {
+		return nil
+	}
+	markets, err := r.availableMarkets(ctx)
+	if err != nil {
+		return nil
+	}
+	return lo.SliceToMap(markets, func(market model.AvailableMarket) (model.AvailableMarket, struct{}) {
+		return market, struct{}{}
+	})
+}
+
+func isAvailable(available map[model.AvailableMarket]struct{}, market model.AvailableMarket) bool {
+	if len(available) == 0 {
+		return true
+	}
+	_, ok := available[market]
+	return ok
+}
+
+func findCountry(input string) (gountries.Country, bool) {
+	query := gountries.New()
+	if len(input) == 2 || len(input) == 3 {
+		if country, err := query.FindCountryByAlpha(input); err == nil {
+			return country, true
+		}
+	}
+	country, err := query.FindCountryByName(input)
+	return country, err == nil
+}
+
+// closeMatches answers the served countries whose name or codes are a few edits away from the input, or whose name
+// contains it, closest first
+func closeMatches(input string, available map[model.AvailableMarket]struct{}) []string {
+	input = strings.ToLower(input)
+	if input == "" {
+		return nil
+	}
+	maxDistance := max(1, len([]rune(input))/3)
+
+	type match struct {
+		country  gountries.Country
+		distance int
+	}
+	var matches []match
+	for _, country := range countries() {
+		if !isAvailable(available, model.AvailableMarket(country.Alpha2)) {
+			continue
+		}
+		name := strings.ToLower(country.Name.Common)
+		distance := min(
+			levenshtein(input, name),
+			levenshtein(input, strings.ToLower(country.Alpha2)),
+			levenshtein(input, strings.ToLower(country.Alpha3)),
+		)
+		if len(input) > 3 && strings.Contains(name, input) {
+			distance = 0
+		}
+		if distance <= maxDistance {
+			matches = append(matches, match{country: country, distance: distance})
 		}
-		market = lo.ToPtr(model.AvailableMarket(country.Alpha2))
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(matches, func(a, b match) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
 
+	return lo.Map(matches[:min(len(matches), maxMarketSuggestions)], func(m match, _ int) string {
+		return formatCountry(m.country)
+	})
+}
+
+func formatCountry(country gountries.Country) string {
+	return fmt.Sprintf("%s (%s)", country.Name.Common, country.Alpha2)
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			substitution := previous[j-1]
+			if ra[i-1] != rb[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
 	}
-	return market, nil
+	return previous[len(rb)]
 }
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"context"
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
 	"jezz-go-spotify-integration/internal/model"
 	"reflect"
 	"testing"
@@ -8,44 +11,167 @@ import (
 	"github.com/samber/lo"
 )
 
-func TestGetMarketByCountryName(t *testing.T) {
-	type args struct {
-		countryName *string
-	}
+func TestMarketResolver_Resolve(t *testing.T) {
 	tests := []struct {
-		name    string
-		args    args
-		want    *model.AvailableMarket
-		wantErr bool
+		name             string
+		availableMarkets []model.AvailableMarket
+		market           *string
+		want             *model.AvailableMarket
+		wantErr          bool
+		wantSuggestions  []string
 	}{
 		{
-			name:    "should return nil when country name is nil",
-			args:    args{countryName: nil},
-			want:    nil,
-			wantErr: false,
+			name:   "should return nil when market is nil",
+			market: nil,
+			want:   nil,
+		},
+		{
+			name:   "should return available market with alpha2 from country when country name is valid",
+			market: lo.ToPtr("Brazil"),
+			want:   lo.ToPtr(model.AvailableMarket("BR")),
+		},
+		{
+			name:   "should resolve country name case-insensitively",
+			market: lo.ToPtr("bRAZIL"),
+			want:   lo.ToPtr(model.AvailableMarket("BR")),
+		},
+		{
+			name:   "should resolve alpha2 code",
+			market: lo.ToPtr("br"),
+			want:   lo.ToPtr(model.AvailableMarket("BR")),
+		},
+		{
+			name:   "should resolve alpha3 code",
+			market: lo.ToPtr("GBR"),
+			want:   lo.ToPtr(model.AvailableMarket("GB")),
+		},
+		{
+			name:   "should resolve from_token",
+			market: lo.ToPtr("From_Token"),
+			want:   lo.ToPtr(FromTokenMarket),
 		},
 		{
-			name:    "should return available market with alpha2 from country when country name is valid",
-			args:    args{countryName: lo.ToPtr("Brazil")},
-			want:    lo.ToPtr(model.AvailableMarket("BR")),
-			wantErr: false,
+			name:            "should return error with close matches when country name has a typo",
+			market:          lo.ToPtr("Brasil"),
+			wantErr:         true,
+			wantSuggestions: []string{"Brazil (BR)"},
 		},
 		{
-			name:    "should return error when country name is invalid",
-			args:    args{countryName: lo.ToPtr("Not Valid")},
-			want:    nil,
+			name:    "should return error when market is invalid",
+			market:  lo.ToPtr("Not Valid"),
 			wantErr: true,
 		},
+		{
+			name:             "should return market when spotify serves the country",
+			availableMarkets: []model.AvailableMarket{"BR", "MX"},
+			market:           lo.ToPtr("Brazil"),
+			want:             lo.ToPtr(model.AvailableMarket("BR")),
+		},
+		{
+			name:             "should return error when spotify does not serve the country",
+			availableMarkets: []model.AvailableMarket{"BR", "MX"},
+			market:           lo.ToPtr("ARG"),
+			wantErr:          true,
+		},
+		{
+			name:             "should only suggest markets spotify serves",
+			availableMarkets: []model.AvailableMarket{"BR", "MX"},
+			market:           lo.ToPtr("Mexic"),
+			wantErr:          true,
+			wantSuggestions:  []string{"Mexico (MX)"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := GetMarketByCountryName(tt.args.countryName)
-			if tt.wantErr && err == nil {
-				t.Errorf("GetMarketByCountryName() error = %v, wantErr %v", err, tt.wantErr)
+			got, err := NewMarketResolver(tt.availableMarkets).Resolve(context.Background(), tt.market)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolve() got = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr {
 				return
 			}
+			var marketErr commons.InvalidMarketError
+			if !errors.As(err, &marketErr) {
+				t.Fatalf("Resolve() error of unexpected type %T, want commons.InvalidMarketError", err)
+			}
+			if !reflect.DeepEqual(marketErr.Suggestions, tt.wantSuggestions) {
+				t.Errorf("Resolve() suggestions = %v, want %v", marketErr.Suggestions, tt.wantSuggestions)
+			}
+		})
+	}
+}
+
+func TestMarketResolver_Resolve_NilResolver(t *testing.T) {
+	var r *MarketResolver
+	got, err := r.Resolve(context.Background(), lo.ToPtr("Brazil"))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if want := lo.ToPtr(model.AvailableMarket("BR")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolve() got = %v, want %v", got, want)
+	}
+}
+
+func TestNewLazyMarketResolver(t *testing.T) {
+	tests := []struct {
+		name      string
+		market    *string
+		marketsFn func(calls *int) AvailableMarketsFn
+		want      *model.AvailableMarket
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:   "should not get the available markets when no market is given",
+			market: nil,
+			marketsFn: func(calls *int) AvailableMarketsFn {
+				return func(context.Context) ([]model.AvailableMarket, error) {
+					*calls++
+					return []model.AvailableMarket{"BR"}, nil
+				}
+			},
+		},
+		{
+			name:   "should check the market against the available markets",
+			market: lo.ToPtr("Argentina"),
+			marketsFn: func(calls *int) AvailableMarketsFn {
+				return func(context.Context) ([]model.AvailableMarket, error) {
+					*calls++
+					return []model.AvailableMarket{"BR"}, nil
+				}
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:   "should accept any market when the available markets can't be got",
+			market: lo.ToPtr("Argentina"),
+			marketsFn: func(calls *int) AvailableMarketsFn {
+				return func(context.Context) ([]model.AvailableMarket, error) {
+					*calls++
+					return nil, errors.New("mock markets error")
+				}
+			},
+			want:      lo.ToPtr(model.AvailableMarket("AR")),
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := NewLazyMarketResolver(tt.marketsFn(&calls)).Resolve(context.Background(), tt.market)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetMarketByCountryName() got = %v, want %v", got, tt.want)
+				t.Errorf("Resolve() got = %v, want %v", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Resolve() got the available markets %d times, want %d", calls, tt.wantCalls)
 			}
 		})
 	}
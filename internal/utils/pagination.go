@@ -2,15 +2,34 @@ package utils
 
 import (
 	"fmt"
+	"iter"
 	"jezz-go-spotify-integration/internal/model"
+	"net/url"
+	"strconv"
 )
 
+// MaxPaginationLimit is the largest page size accepted by the Spotify API.
+const MaxPaginationLimit = 50
+
+// FetchPageFn fetches the page starting at offset with at most limit items.
+type FetchPageFn[T any] func(limit model.Limit, offset model.Offset) ([]T, model.Pagination, error)
+
 func ValidatePaginationParams(
 	limit *model.Limit,
 	offset *model.Offset,
 ) error {
-	if limit != nil && (limit.Int() < 0 || limit.Int() > 50) {
-		err := fmt.Errorf("limit is invalid - must be between 0 and 50")
+	return ValidatePaginationParamsWithMax(limit, offset, MaxPaginationLimit)
+}
+
+// ValidatePaginationParamsWithMax validates the pagination of the endpoints accepting pages of up to maxLimit items
+// instead of MaxPaginationLimit.
+func ValidatePaginationParamsWithMax(
+	limit *model.Limit,
+	offset *model.Offset,
+	maxLimit int,
+) error {
+	if limit != nil && (limit.Int() < 0 || limit.Int() > maxLimit) {
+		err := fmt.Errorf("limit is invalid - must be between 0 and %d", maxLimit)
 		return err
 	}
 
@@ -21,3 +40,67 @@ func ValidatePaginationParams(
 
 	return nil
 }
+
+// Paginate iterates over every item of a paginated endpoint, fetching pages lazily until the API reports there
+// is no next page. The offset of the next page is taken from the next link, falling back to the items already
+// read. When maxItems is greater than 0 no more than maxItems items are yielded. A failed page is yielded as
+// the error of a zero item and ends the iteration.
+func Paginate[T any](fetchPage FetchPageFn[T], maxItems int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset := model.Offset(0)
+		yielded := 0
+		for {
+			limit := MaxPaginationLimit
+			if maxItems > 0 {
+				limit = min(limit, maxItems-yielded)
+			}
+			items, pagination, err := fetchPage(model.Limit(limit), offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+				if maxItems > 0 && yielded >= maxItems {
+					return
+				}
+			}
+			if pagination.Next == nil || len(items) == 0 {
+				return
+			}
+			offset = nextPageOffset(pagination, len(items))
+			if pagination.Total > 0 && offset.Int() >= int(pagination.Total) {
+				return
+			}
+		}
+	}
+}
+
+// CollectAll drains a paginated sequence, stopping at the first error.
+func CollectAll[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func nextPageOffset(pagination model.Pagination, itemsRead int) model.Offset {
+	fallback := pagination.Offset + model.Offset(itemsRead)
+	next, err := url.Parse(string(*pagination.Next))
+	if err != nil {
+		return fallback
+	}
+	offset, err := strconv.Atoi(next.Query().Get("offset"))
+	if err != nil || offset <= pagination.Offset.Int() {
+		return fallback
+	}
+	return model.Offset(offset)
+}
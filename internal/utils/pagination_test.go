@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
 	"jezz-go-spotify-integration/internal/model"
+	"reflect"
 	"testing"
 
 	"github.com/samber/lo"
@@ -66,3 +69,134 @@ func TestValidatePaginationParams(t *testing.T) {
 		})
 	}
 }
+
+// fakePages serves total items in pages, advertising the next page the same way the Spotify API does.
+func fakePages(t *testing.T, total int, failAtOffset int, requested *[]model.Offset) FetchPageFn[int] {
+	return func(limit model.Limit, offset model.Offset) ([]int, model.Pagination, error) {
+		*requested = append(*requested, offset)
+		if limit.Int() <= 0 || limit.Int() > MaxPaginationLimit {
+			t.Errorf("fetchPage() called with invalid limit %d", limit)
+		}
+		if failAtOffset >= 0 && offset.Int() == failAtOffset {
+			return nil, model.Pagination{}, errors.New("mock page error")
+		}
+		end := min(offset.Int()+limit.Int(), total)
+		items := make([]int, 0, end-offset.Int())
+		for i := offset.Int(); i < end; i++ {
+			items = append(items, i)
+		}
+		pagination := model.Pagination{Limit: limit, Offset: offset, Total: model.Total(total)}
+		if end < total {
+			pagination.Next = lo.ToPtr(model.Next(fmt.Sprintf("https://api.spotify.com/v1/albums/x/tracks?offset=%d&limit=%d", end, limit)))
+		}
+		return items, pagination, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         int
+		maxItems      int
+		failAtOffset  int
+		wantItems     int
+		wantRequested []model.Offset
+		wantErr       bool
+	}{
+		{
+			name:          "should iterate over every page until there is no next page",
+			total:         120,
+			failAtOffset:  -1,
+			wantItems:     120,
+			wantRequested: []model.Offset{0, 50, 100},
+		},
+		{
+			name:          "should fetch a single page when everything fits in it",
+			total:         7,
+			failAtOffset:  -1,
+			wantItems:     7,
+			wantRequested: []model.Offset{0},
+		},
+		{
+			name:          "should stop at the first empty page",
+			total:         0,
+			failAtOffset:  -1,
+			wantItems:     0,
+			wantRequested: []model.Offset{0},
+		},
+		{
+			name:          "should not fetch more than max items",
+			total:         120,
+			maxItems:      60,
+			failAtOffset:  -1,
+			wantItems:     60,
+			wantRequested: []model.Offset{0, 50},
+		},
+		{
+			name:          "should yield the error of a failed page and stop",
+			total:         120,
+			failAtOffset:  50,
+			wantItems:     50,
+			wantRequested: []model.Offset{0, 50},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []model.Offset
+			var got []int
+			var gotErr error
+			for item, err := range Paginate(fakePages(t, tt.total, tt.failAtOffset, &requested), tt.maxItems) {
+				if err != nil {
+					gotErr = err
+					continue
+				}
+				got = append(got, item)
+			}
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("Paginate() error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+			if len(got) != tt.wantItems {
+				t.Errorf("Paginate() yielded %d items, want %d", len(got), tt.wantItems)
+			}
+			for i, item := range got {
+				if item != i {
+					t.Fatalf("Paginate() item[%d] = %d, want %d", i, item, i)
+				}
+			}
+			if !reflect.DeepEqual(requested, tt.wantRequested) {
+				t.Errorf("Paginate() requested offsets %v, want %v", requested, tt.wantRequested)
+			}
+		})
+	}
+}
+
+func TestPaginate_StopsWhenConsumerBreaks(t *testing.T) {
+	var requested []model.Offset
+	for item := range Paginate(fakePages(t, 120, -1, &requested), 0) {
+		if item == 10 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(requested, []model.Offset{0}) {
+		t.Errorf("Paginate() requested offsets %v, want [0]", requested)
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	t.Run("should collect every item", func(t *testing.T) {
+		var requested []model.Offset
+		got, err := CollectAll(Paginate(fakePages(t, 75, -1, &requested), 0))
+		if err != nil || len(got) != 75 {
+			t.Errorf("CollectAll() = %d items, %v, want 75 items", len(got), err)
+		}
+	})
+	t.Run("should return the first error", func(t *testing.T) {
+		var requested []model.Offset
+		got, err := CollectAll(Paginate(fakePages(t, 75, 50, &requested), 0))
+		if err == nil || got != nil {
+			t.Errorf("CollectAll() = %v, %v, want nil and error", got, err)
+		}
+	})
+}
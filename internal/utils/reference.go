@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	neturl "net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const openSpotifyHost = "open.spotify.com"
+
+var (
+	base62IDPattern = regexp.MustCompile(`^[0-9A-Za-z]{22}$`)
+	// localePathPattern matches the locale prefix of localized share links, e.g. "/intl-es/track/<id>"
+	localePathPattern = regexp.MustCompile(`^intl-[a-z]{2}(-[a-z]{2})?$`)
+
+	entityTypes = []model.EntityType{
+		model.EntityTypeAlbum,
+		model.EntityTypeArtist,
+		model.EntityTypeTrack,
+		model.EntityTypePlaylist,
+		model.EntityTypeShow,
+		model.EntityTypeEpisode,
+		model.EntityTypeAudiobook,
+		model.EntityTypeChapter,
+	}
+	// audiobooks and chapters are shared with the show and episode URIs and links
+	compatibleEntityTypes = map[model.EntityType][]model.EntityType{
+		model.EntityTypeAudiobook: {model.EntityTypeShow},
+		model.EntityTypeChapter:   {model.EntityTypeEpisode},
+	}
+)
+
+// ParseReference parses a Spotify URI, e.g. "spotify:album:4aawyAB9vmqN3uQ7FjRGTy", or a share link, e.g.
+// "https://open.spotify.com/track/11dFghVXANMlKmJXsNCbNl?si=...", into the entity it points to.
+func ParseReference(input string) (model.Reference, error) {
+	trimmed := strings.TrimSpace(input)
+
+	var segments []string
+	switch {
+	case strings.HasPrefix(trimmed, model.URIScheme+":"):
+		segments = strings.Split(strings.TrimPrefix(trimmed, model.URIScheme+":"), ":")
+	case strings.Contains(trimmed, openSpotifyHost):
+		var err error
+		if segments, err = shareLinkSegments(trimmed); err != nil {
+			return model.Reference{}, commons.InvalidReferenceError{Input: input, Reason: err.Error()}
+		}
+	default:
+		return model.Reference{}, commons.InvalidReferenceError{Input: input, Reason: "not a spotify uri or open.spotify.com link"}
+	}
+
+	// legacy playlist references are scoped by their owner, e.g. "spotify:user:<user id>:playlist:<id>"
+	if len(segments) == 4 && segments[0] == "user" && segments[2] == model.EntityTypePlaylist.String() {
+		segments = segments[2:]
+	}
+	if len(segments) != 2 {
+		return model.Reference{}, commons.InvalidReferenceError{Input: input, Reason: "expected an entity type followed by an id"}
+	}
+
+	reference := model.Reference{Type: model.EntityType(segments[0]), ID: model.ID(segments[1])}
+	if !slices.Contains(entityTypes, reference.Type) {
+		return model.Reference{}, commons.InvalidReferenceError{Input: input, Reason: fmt.Sprintf("unsupported entity type %q", reference.Type)}
+	}
+	if !IsValidID(reference.ID) {
+		return model.Reference{}, commons.InvalidReferenceError{Input: input, Reason: "id must be 22 base62 characters"}
+	}
+	return reference, nil
+}
+
+// ParseID answers the ID of an entity of the given type from its ID, Spotify URI or share link. References to
+// another type of entity are rejected.
+func ParseID(input string, entityType model.EntityType) (model.ID, error) {
+	trimmed := strings.TrimSpace(input)
+	if !strings.ContainsAny(trimmed, ":/") {
+		if !IsValidID(model.ID(trimmed)) {
+			return "", commons.InvalidReferenceError{Input: input, Expected: entityType.String(), Reason: "id must be 22 base62 characters"}
+		}
+		return model.ID(trimmed), nil
+	}
+
+	reference, err := ParseReference(trimmed)
+	var refErr commons.InvalidReferenceError
+	if errors.As(err, &refErr) {
+		refErr.Input, refErr.Expected = input, entityType.String()
+		return "", refErr
+	}
+	if err != nil {
+		return "", err
+	}
+	if reference.Type != entityType && !slices.Contains(compatibleEntityTypes[entityType], reference.Type) {
+		return "", commons.InvalidReferenceError{
+			Input:    input,
+			Expected: entityType.String(),
+			Reason:   fmt.Sprintf("reference of type %s where %s is expected", reference.Type, entityType),
+		}
+	}
+	return reference.ID, nil
+}
+
+// IsValidID tells whether id is a Spotify ID, 22 base62 characters
+func IsValidID(id model.ID) bool {
+	return base62IDPattern.MatchString(id.String())
+}
+
+func shareLinkSegments(link string) ([]string, error) {
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	parsed, err := neturl.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link - %w", err)
+	}
+	if parsed.Host != openSpotifyHost {
+		return nil, fmt.Errorf("not an %s link", openSpotifyHost)
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) > 0 && localePathPattern.MatchString(segments[0]) {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 && segments[0] == "embed" {
+		segments = segments[1:]
+	}
+	return segments, nil
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"errors"
+	"jezz-go-spotify-integration/internal/commons"
+	"jezz-go-spotify-integration/internal/model"
+	"reflect"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    model.Reference
+		wantErr bool
+	}{
+		{
+			name:  "should parse uris",
+			input: "spotify:album:4aawyAB9vmqN3uQ7FjRGTy",
+			want:  model.Reference{Type: model.EntityTypeAlbum, ID: "4aawyAB9vmqN3uQ7FjRGTy"},
+		},
+		{
+			name:  "should parse legacy playlist uris scoped by user",
+			input: "spotify:user:jmperezperez:playlist:3cEYpjA9oz9GiPac4AsH4n",
+			want:  model.Reference{Type: model.EntityTypePlaylist, ID: "3cEYpjA9oz9GiPac4AsH4n"},
+		},
+		{
+			name:  "should parse share links ignoring the query",
+			input: "https://open.spotify.com/track/11dFghVXANMlKmJXsNCbNl?si=a1b2c3d4e5f64a7b",
+			want:  model.Reference{Type: model.EntityTypeTrack, ID: "11dFghVXANMlKmJXsNCbNl"},
+		},
+		{
+			name:  "should parse localized share links without scheme",
+			input: " open.spotify.com/intl-pt/artist/0TnOYISbd1XYRBk9myaseg ",
+			want:  model.Reference{Type: model.EntityTypeArtist, ID: "0TnOYISbd1XYRBk9myaseg"},
+		},
+		{
+			name:  "should parse embed links",
+			input: "https://open.spotify.com/embed/episode/512ojhOuo1ktJprKbVcKyQ",
+			want:  model.Reference{Type: model.EntityTypeEpisode, ID: "512ojhOuo1ktJprKbVcKyQ"},
+		},
+		{name: "should return error on raw ids", input: "4aawyAB9vmqN3uQ7FjRGTy", wantErr: true},
+		{name: "should return error on ids which are not base62", input: "spotify:album:4aawyAB9vmqN3uQ7FjRG-y", wantErr: true},
+		{name: "should return error on ids with the wrong length", input: "spotify:album:4aawyAB9", wantErr: true},
+		{name: "should return error on unsupported entity types", input: "spotify:user:jmperezperez", wantErr: true},
+		{name: "should return error on links to other hosts", input: "https://example.com/open.spotify.com/track/11dFghVXANMlKmJXsNCbNl", wantErr: true},
+		{name: "should return error on links without id", input: "https://open.spotify.com/track", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReference(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseReference() got = %+v, want %+v", got, tt.want)
+			}
+			if !tt.wantErr {
+				if again, _ := ParseReference(string(got.URI())); !reflect.DeepEqual(again, got) {
+					t.Errorf("ParseReference() of formatted uri %s = %+v, want %+v", got.URI(), again, got)
+				}
+				if again, _ := ParseReference(string(got.URL())); !reflect.DeepEqual(again, got) {
+					t.Errorf("ParseReference() of formatted url %s = %+v, want %+v", got.URL(), again, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		entityType model.EntityType
+		want       model.ID
+		wantErr    bool
+	}{
+		{name: "should accept ids", input: "4aawyAB9vmqN3uQ7FjRGTy", entityType: model.EntityTypeAlbum, want: "4aawyAB9vmqN3uQ7FjRGTy"},
+		{name: "should accept uris", input: "spotify:album:4aawyAB9vmqN3uQ7FjRGTy", entityType: model.EntityTypeAlbum, want: "4aawyAB9vmqN3uQ7FjRGTy"},
+		{name: "should accept share links", input: "https://open.spotify.com/album/4aawyAB9vmqN3uQ7FjRGTy?si=x", entityType: model.EntityTypeAlbum, want: "4aawyAB9vmqN3uQ7FjRGTy"},
+		{name: "should accept show links for audiobooks", input: "https://open.spotify.com/show/7iHfbu1YPACw6oZPAFJtqe", entityType: model.EntityTypeAudiobook, want: "7iHfbu1YPACw6oZPAFJtqe"},
+		{name: "should reject references to another entity type", input: "spotify:track:11dFghVXANMlKmJXsNCbNl", entityType: model.EntityTypeAlbum, wantErr: true},
+		{name: "should reject invalid ids", input: "not an id", entityType: model.EntityTypeAlbum, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseID(tt.input, tt.entityType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseID() got = %v, want %v", got, tt.want)
+			}
+			var refErr commons.InvalidReferenceError
+			if tt.wantErr && (!errors.As(err, &refErr) || refErr.Input != tt.input || refErr.Expected != tt.entityType.String()) {
+				t.Errorf("ParseID() error = %#v, want commons.InvalidReferenceError for %q", err, tt.input)
+			}
+		})
+	}
+}
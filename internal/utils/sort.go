@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"cmp"
+	"jezz-go-spotify-integration/internal/model"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// Released is implemented by albums, episodes and chapters, including the types embedding them
+type Released interface {
+	ReleasedOn() model.ReleaseDate
+}
+
+// Timed is implemented by tracks, episodes and chapters, including the types embedding them
+type Timed interface {
+	Duration() time.Duration
+}
+
+// SortByReleaseDate sorts the items from the oldest to the newest release, see model.ReleaseDate.Compare. Items
+// released on the same date keep their order.
+func SortByReleaseDate[T Released](items []T) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return a.ReleasedOn().Compare(b.ReleasedOn())
+	})
+}
+
+// ReleasedBetween answers the items released from the first day of from up to the last day of to, both inclusive,
+// keeping their order. A release date less precise than the range, e.g. just a year, counts when any of its days
+// is within it. A zero from or to leaves that end of the range open; items with unknown release dates are left out.
+func ReleasedBetween[T Released](items []T, from model.ReleaseDate, to model.ReleaseDate) []T {
+	return lo.Filter(items, func(item T, _ int) bool {
+		releaseDate := item.ReleasedOn()
+		if releaseDate.IsZero() {
+			return false
+		}
+		startsBeforeEnd := to.IsZero() || releaseDate.Time().Before(to.End())
+		endsAfterStart := from.IsZero() || releaseDate.End().After(from.Time())
+		return startsBeforeEnd && endsAfterStart
+	})
+}
+
+// SortByDuration sorts the items from the shortest to the longest, items as long keeping their order
+func SortByDuration[T Timed](items []T) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Compare(a.Duration(), b.Duration())
+	})
+}
+
+// DurationBetween answers the items lasting from minDuration to maxDuration, both inclusive, keeping their order.
+// A maxDuration of 0 leaves the range open.
+func DurationBetween[T Timed](items []T, minDuration time.Duration, maxDuration time.Duration) []T {
+	return lo.Filter(items, func(item T, _ int) bool {
+		duration := item.Duration()
+		return duration >= minDuration && (maxDuration == 0 || duration <= maxDuration)
+	})
+}
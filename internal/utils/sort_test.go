@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"jezz-go-spotify-integration/internal/model"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func newAlbums(t *testing.T, releaseDates ...string) []model.SimplifiedAlbum {
+	t.Helper()
+	return lo.Map(releaseDates, func(value string, _ int) model.SimplifiedAlbum {
+		releaseDate, err := model.ParseReleaseDate(value)
+		if err != nil {
+			t.Fatalf("ParseReleaseDate() error = %v", err)
+		}
+		return model.SimplifiedAlbum{Name: model.Name(value), ReleaseDate: releaseDate}
+	})
+}
+
+func albumNames(albums []model.SimplifiedAlbum) []model.Name {
+	return lo.Map(albums, func(album model.SimplifiedAlbum, _ int) model.Name {
+		return album.Name
+	})
+}
+
+func TestSortByReleaseDate(t *testing.T) {
+	albums := newAlbums(t, "2012-11-16", "1999", "", "2012-11", "1999-01-01")
+	SortByReleaseDate(albums)
+
+	want := []model.Name{"", "1999", "1999-01-01", "2012-11", "2012-11-16"}
+	if got := albumNames(albums); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortByReleaseDate() = %v, want %v", got, want)
+	}
+}
+
+func TestReleasedBetween(t *testing.T) {
+	albums := newAlbums(t, "1999", "2011-12-31", "2012", "2012-06", "2012-11-16", "2013-01-01", "")
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []model.Name
+	}{
+		{name: "should include both ends", from: "2012-06-01", to: "2012-11-16", want: []model.Name{"2012", "2012-06", "2012-11-16"}},
+		{name: "should take the whole period of less precise bounds", from: "2012", to: "2012", want: []model.Name{"2012", "2012-06", "2012-11-16"}},
+		{name: "should leave the start open", to: "2011", want: []model.Name{"1999", "2011-12-31"}},
+		{name: "should leave the end open", from: "2012-12", want: []model.Name{"2012", "2013-01-01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, _ := model.ParseReleaseDate(tt.from)
+			to, _ := model.ParseReleaseDate(tt.to)
+			if got := albumNames(ReleasedBetween(albums, from, to)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReleasedBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortByDuration_DurationBetween(t *testing.T) {
+	tracks := []model.Track{
+		{SimplifiedTrack: model.SimplifiedTrack{ID: "long", DurationMs: 376000}},
+		{SimplifiedTrack: model.SimplifiedTrack{ID: "short", DurationMs: 85400}},
+		{SimplifiedTrack: model.SimplifiedTrack{ID: "medium", DurationMs: 207959}},
+	}
+	trackIDs := func(tracks []model.Track) []model.ID {
+		return lo.Map(tracks, func(track model.Track, _ int) model.ID {
+			return track.ID
+		})
+	}
+
+	filtered := DurationBetween(tracks, 90*time.Second, 0)
+	if want := []model.ID{"long", "medium"}; !reflect.DeepEqual(trackIDs(filtered), want) {
+		t.Errorf("DurationBetween() = %v, want %v", trackIDs(filtered), want)
+	}
+	SortByDuration(tracks)
+	if want := []model.ID{"short", "medium", "long"}; !reflect.DeepEqual(trackIDs(tracks), want) {
+		t.Errorf("SortByDuration() = %v, want %v", trackIDs(tracks), want)
+	}
+	if got := tracks[0].Duration(); got != 85400*time.Millisecond {
+		t.Errorf("Duration() = %v, want %v", got, 85400*time.Millisecond)
+	}
+}